@@ -19,21 +19,33 @@ import (
 // pass the data to a BookingAPIServicer to perform the required actions, then write the service results to the http response.
 type BookingAPIRouter interface {
 	BookingsAuthorizeGet(http.ResponseWriter, *http.Request)
+	BookingsAuthorizeStatusGet(http.ResponseWriter, *http.Request)
 	BookingsBookingIdDeletePost(http.ResponseWriter, *http.Request)
 	BookingsBookingIdRegisterGuestPost(http.ResponseWriter, *http.Request)
+	BookingsDeviceCodeGet(http.ResponseWriter, *http.Request)
 	BookingsGet(http.ResponseWriter, *http.Request)
 	BookingsPost(http.ResponseWriter, *http.Request)
+	BookingsSessionsGet(http.ResponseWriter, *http.Request)
+	BookingsSessionsSessionIdDelete(http.ResponseWriter, *http.Request)
 }
 
 // ConfigurationAPIRouter defines the required methods for binding the api requests to a responses for the ConfigurationAPI
 // The ConfigurationAPIRouter implementation should parse necessary information from the http request,
 // pass the data to a ConfigurationAPIServicer to perform the required actions, then write the service results to the http response.
 type ConfigurationAPIRouter interface {
+	DeleteAssetMappingById(http.ResponseWriter, *http.Request)
 	DeleteConfigurationById(http.ResponseWriter, *http.Request)
+	DeletePresenceLinkById(http.ResponseWriter, *http.Request)
+	GetAssetMappings(http.ResponseWriter, *http.Request)
 	GetConfigurationById(http.ResponseWriter, *http.Request)
+	GetConfigurationStatusById(http.ResponseWriter, *http.Request)
 	GetConfigurations(http.ResponseWriter, *http.Request)
+	GetPresenceLinks(http.ResponseWriter, *http.Request)
+	PostAssetMapping(http.ResponseWriter, *http.Request)
 	PostConfiguration(http.ResponseWriter, *http.Request)
+	PostPresenceLink(http.ResponseWriter, *http.Request)
 	PutConfigurationById(http.ResponseWriter, *http.Request)
+	TestConfigurationById(http.ResponseWriter, *http.Request)
 }
 
 // CustomizationAPIRouter defines the required methods for binding the api requests to a responses for the CustomizationAPI
@@ -43,6 +55,14 @@ type CustomizationAPIRouter interface {
 	GetDashboardTemplateByName(http.ResponseWriter, *http.Request)
 }
 
+// HealthAPIRouter defines the required methods for binding the api requests to a responses for the HealthAPI
+// The HealthAPIRouter implementation should parse necessary information from the http request,
+// pass the data to a HealthAPIServicer to perform the required actions, then write the service results to the http response.
+type HealthAPIRouter interface {
+	GetLiveness(http.ResponseWriter, *http.Request)
+	GetReadiness(http.ResponseWriter, *http.Request)
+}
+
 // ProxyAPIRouter defines the required methods for binding the api requests to a responses for the ProxyAPI
 // The ProxyAPIRouter implementation should parse necessary information from the http request,
 // pass the data to a ProxyAPIServicer to perform the required actions, then write the service results to the http response.
@@ -67,10 +87,14 @@ type VersionAPIRouter interface {
 // and updated with the logic required for the API.
 type BookingAPIServicer interface {
 	BookingsAuthorizeGet(context.Context, string) (ImplResponse, error)
+	BookingsAuthorizeStatusGet(context.Context, string) (ImplResponse, error)
 	BookingsBookingIdDeletePost(context.Context, string, DeleteBookingRequest) (ImplResponse, error)
 	BookingsBookingIdRegisterGuestPost(context.Context, string, BookingsBookingIdRegisterGuestPostRequest) (ImplResponse, error)
+	BookingsDeviceCodeGet(context.Context, string) (ImplResponse, error)
 	BookingsGet(context.Context, string, string, string) (ImplResponse, error)
 	BookingsPost(context.Context, CreateBookingRequest) (ImplResponse, error)
+	BookingsSessionsGet(context.Context) (ImplResponse, error)
+	BookingsSessionsSessionIdDelete(context.Context, int64) (ImplResponse, error)
 }
 
 // ConfigurationAPIServicer defines the api actions for the ConfigurationAPI service
@@ -78,11 +102,19 @@ type BookingAPIServicer interface {
 // while the service implementation can be ignored with the .openapi-generator-ignore file
 // and updated with the logic required for the API.
 type ConfigurationAPIServicer interface {
+	DeleteAssetMappingById(context.Context, int64, int64) (ImplResponse, error)
 	DeleteConfigurationById(context.Context, int64) (ImplResponse, error)
+	DeletePresenceLinkById(context.Context, int64, int64) (ImplResponse, error)
+	GetAssetMappings(context.Context, int64) (ImplResponse, error)
 	GetConfigurationById(context.Context, int64) (ImplResponse, error)
+	GetConfigurationStatusById(context.Context, int64) (ImplResponse, error)
 	GetConfigurations(context.Context) (ImplResponse, error)
+	GetPresenceLinks(context.Context, int64) (ImplResponse, error)
+	PostAssetMapping(context.Context, int64, AssetMapping) (ImplResponse, error)
 	PostConfiguration(context.Context, Configuration) (ImplResponse, error)
+	PostPresenceLink(context.Context, int64, PresenceLink) (ImplResponse, error)
 	PutConfigurationById(context.Context, int64, Configuration) (ImplResponse, error)
+	TestConfigurationById(context.Context, int64) (ImplResponse, error)
 }
 
 // CustomizationAPIServicer defines the api actions for the CustomizationAPI service
@@ -93,6 +125,15 @@ type CustomizationAPIServicer interface {
 	GetDashboardTemplateByName(context.Context, string, string) (ImplResponse, error)
 }
 
+// HealthAPIServicer defines the api actions for the HealthAPI service
+// This interface intended to stay up to date with the openapi yaml used to generate it,
+// while the service implementation can be ignored with the .openapi-generator-ignore file
+// and updated with the logic required for the API.
+type HealthAPIServicer interface {
+	GetLiveness(context.Context) (ImplResponse, error)
+	GetReadiness(context.Context) (ImplResponse, error)
+}
+
 // ProxyAPIServicer defines the api actions for the ProxyAPI service
 // This interface intended to stay up to date with the openapi yaml used to generate it,
 // while the service implementation can be ignored with the .openapi-generator-ignore file
@@ -55,6 +55,11 @@ func (c *BookingAPIController) Routes() Routes {
 			"/v1/bookings/authorize",
 			c.BookingsAuthorizeGet,
 		},
+		"BookingsAuthorizeStatusGet": Route{
+			strings.ToUpper("Get"),
+			"/v1/bookings/authorize/status",
+			c.BookingsAuthorizeStatusGet,
+		},
 		"BookingsBookingIdDeletePost": Route{
 			strings.ToUpper("Post"),
 			"/v1/bookings/{bookingId}/delete",
@@ -65,6 +70,11 @@ func (c *BookingAPIController) Routes() Routes {
 			"/v1/bookings/{bookingId}/registerGuest",
 			c.BookingsBookingIdRegisterGuestPost,
 		},
+		"BookingsDeviceCodeGet": Route{
+			strings.ToUpper("Get"),
+			"/v1/bookings/device-code",
+			c.BookingsDeviceCodeGet,
+		},
 		"BookingsGet": Route{
 			strings.ToUpper("Get"),
 			"/v1/bookings",
@@ -75,6 +85,16 @@ func (c *BookingAPIController) Routes() Routes {
 			"/v1/bookings",
 			c.BookingsPost,
 		},
+		"BookingsSessionsGet": Route{
+			strings.ToUpper("Get"),
+			"/v1/bookings/sessions",
+			c.BookingsSessionsGet,
+		},
+		"BookingsSessionsSessionIdDelete": Route{
+			strings.ToUpper("Delete"),
+			"/v1/bookings/sessions/{sessionId}",
+			c.BookingsSessionsSessionIdDelete,
+		},
 	}
 }
 
@@ -92,6 +112,20 @@ func (c *BookingAPIController) BookingsAuthorizeGet(w http.ResponseWriter, r *ht
 	EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
+// BookingsAuthorizeStatusGet - Get the status of a device code sign-in
+func (c *BookingAPIController) BookingsAuthorizeStatusGet(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	deviceCodeParam := query.Get("deviceCode")
+	result, err := c.service.BookingsAuthorizeStatusGet(r.Context(), deviceCodeParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // BookingsBookingIdDeletePost - Cancel a booking
 func (c *BookingAPIController) BookingsBookingIdDeletePost(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
@@ -150,6 +184,20 @@ func (c *BookingAPIController) BookingsBookingIdRegisterGuestPost(w http.Respons
 	EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
+// BookingsDeviceCodeGet - Start a device code sign-in for managing bookings
+func (c *BookingAPIController) BookingsDeviceCodeGet(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	assetIdParam := query.Get("assetId")
+	result, err := c.service.BookingsDeviceCodeGet(r.Context(), assetIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // BookingsGet - List bookings
 func (c *BookingAPIController) BookingsGet(w http.ResponseWriter, r *http.Request) {
 	query := r.URL.Query()
@@ -192,3 +240,36 @@ func (c *BookingAPIController) BookingsPost(w http.ResponseWriter, r *http.Reque
 	// If no error, encode the body and the result code
 	EncodeJSONResponse(result.Body, &result.Code, w)
 }
+
+// BookingsSessionsGet - List booking sessions
+func (c *BookingAPIController) BookingsSessionsGet(w http.ResponseWriter, r *http.Request) {
+	result, err := c.service.BookingsSessionsGet(r.Context())
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// BookingsSessionsSessionIdDelete - Revoke a booking session
+func (c *BookingAPIController) BookingsSessionsSessionIdDelete(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	sessionIdParam, err := parseNumericParameter[int64](
+		params["sessionId"],
+		WithRequire[int64](parseInt64),
+	)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	result, err := c.service.BookingsSessionsSessionIdDelete(r.Context(), sessionIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
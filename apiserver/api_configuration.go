@@ -52,34 +52,103 @@ func NewConfigurationAPIController(s ConfigurationAPIServicer, opts ...Configura
 // Routes returns all the api routes for the ConfigurationAPIController
 func (c *ConfigurationAPIController) Routes() Routes {
 	return Routes{
+		"DeleteAssetMappingById": Route{
+			strings.ToUpper("Delete"),
+			"/v1/configs/{config-id}/asset-mappings/{mapping-id}",
+			c.DeleteAssetMappingById,
+		},
 		"DeleteConfigurationById": Route{
 			strings.ToUpper("Delete"),
 			"/v1/configs/{config-id}",
 			c.DeleteConfigurationById,
 		},
+		"DeletePresenceLinkById": Route{
+			strings.ToUpper("Delete"),
+			"/v1/configs/{config-id}/presence-links/{link-id}",
+			c.DeletePresenceLinkById,
+		},
+		"GetAssetMappings": Route{
+			strings.ToUpper("Get"),
+			"/v1/configs/{config-id}/asset-mappings",
+			c.GetAssetMappings,
+		},
 		"GetConfigurationById": Route{
 			strings.ToUpper("Get"),
 			"/v1/configs/{config-id}",
 			c.GetConfigurationById,
 		},
+		"GetConfigurationStatusById": Route{
+			strings.ToUpper("Get"),
+			"/v1/configs/{config-id}/status",
+			c.GetConfigurationStatusById,
+		},
 		"GetConfigurations": Route{
 			strings.ToUpper("Get"),
 			"/v1/configs",
 			c.GetConfigurations,
 		},
+		"GetPresenceLinks": Route{
+			strings.ToUpper("Get"),
+			"/v1/configs/{config-id}/presence-links",
+			c.GetPresenceLinks,
+		},
+		"PostAssetMapping": Route{
+			strings.ToUpper("Post"),
+			"/v1/configs/{config-id}/asset-mappings",
+			c.PostAssetMapping,
+		},
 		"PostConfiguration": Route{
 			strings.ToUpper("Post"),
 			"/v1/configs",
 			c.PostConfiguration,
 		},
+		"PostPresenceLink": Route{
+			strings.ToUpper("Post"),
+			"/v1/configs/{config-id}/presence-links",
+			c.PostPresenceLink,
+		},
 		"PutConfigurationById": Route{
 			strings.ToUpper("Put"),
 			"/v1/configs/{config-id}",
 			c.PutConfigurationById,
 		},
+		"TestConfigurationById": Route{
+			strings.ToUpper("Post"),
+			"/v1/configs/{config-id}/test",
+			c.TestConfigurationById,
+		},
 	}
 }
 
+// DeleteAssetMappingById - Deletes an asset mapping
+func (c *ConfigurationAPIController) DeleteAssetMappingById(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseNumericParameter[int64](
+		params["config-id"],
+		WithRequire[int64](parseInt64),
+	)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	mappingIdParam, err := parseNumericParameter[int64](
+		params["mapping-id"],
+		WithRequire[int64](parseInt64),
+	)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	result, err := c.service.DeleteAssetMappingById(r.Context(), configIdParam, mappingIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // DeleteConfigurationById - Deletes a configuration
 func (c *ConfigurationAPIController) DeleteConfigurationById(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
@@ -101,6 +170,56 @@ func (c *ConfigurationAPIController) DeleteConfigurationById(w http.ResponseWrit
 	EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
+// DeletePresenceLinkById - Deletes a presence link
+func (c *ConfigurationAPIController) DeletePresenceLinkById(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseNumericParameter[int64](
+		params["config-id"],
+		WithRequire[int64](parseInt64),
+	)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	linkIdParam, err := parseNumericParameter[int64](
+		params["link-id"],
+		WithRequire[int64](parseInt64),
+	)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	result, err := c.service.DeletePresenceLinkById(r.Context(), configIdParam, linkIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetAssetMappings - Get asset mappings
+func (c *ConfigurationAPIController) GetAssetMappings(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseNumericParameter[int64](
+		params["config-id"],
+		WithRequire[int64](parseInt64),
+	)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	result, err := c.service.GetAssetMappings(r.Context(), configIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // GetConfigurationById - Get configuration
 func (c *ConfigurationAPIController) GetConfigurationById(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
@@ -122,6 +241,27 @@ func (c *ConfigurationAPIController) GetConfigurationById(w http.ResponseWriter,
 	EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
+// GetConfigurationStatusById - Get the synchronization status of a configuration
+func (c *ConfigurationAPIController) GetConfigurationStatusById(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseNumericParameter[int64](
+		params["config-id"],
+		WithRequire[int64](parseInt64),
+	)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	result, err := c.service.GetConfigurationStatusById(r.Context(), configIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // GetConfigurations - Get configurations
 func (c *ConfigurationAPIController) GetConfigurations(w http.ResponseWriter, r *http.Request) {
 	result, err := c.service.GetConfigurations(r.Context())
@@ -134,6 +274,63 @@ func (c *ConfigurationAPIController) GetConfigurations(w http.ResponseWriter, r
 	EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
+// GetPresenceLinks - Get presence links
+func (c *ConfigurationAPIController) GetPresenceLinks(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseNumericParameter[int64](
+		params["config-id"],
+		WithRequire[int64](parseInt64),
+	)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	result, err := c.service.GetPresenceLinks(r.Context(), configIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// PostAssetMapping - Creates an asset mapping
+func (c *ConfigurationAPIController) PostAssetMapping(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseNumericParameter[int64](
+		params["config-id"],
+		WithRequire[int64](parseInt64),
+	)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	assetMappingParam := AssetMapping{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&assetMappingParam); err != nil && !errors.Is(err, io.EOF) {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertAssetMappingRequired(assetMappingParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertAssetMappingConstraints(assetMappingParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.PostAssetMapping(r.Context(), configIdParam, assetMappingParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // PostConfiguration - Creates a configuration
 func (c *ConfigurationAPIController) PostConfiguration(w http.ResponseWriter, r *http.Request) {
 	configurationParam := Configuration{}
@@ -161,6 +358,42 @@ func (c *ConfigurationAPIController) PostConfiguration(w http.ResponseWriter, r
 	EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
+// PostPresenceLink - Creates a presence link
+func (c *ConfigurationAPIController) PostPresenceLink(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseNumericParameter[int64](
+		params["config-id"],
+		WithRequire[int64](parseInt64),
+	)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	presenceLinkParam := PresenceLink{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&presenceLinkParam); err != nil && !errors.Is(err, io.EOF) {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertPresenceLinkRequired(presenceLinkParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertPresenceLinkConstraints(presenceLinkParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.PostPresenceLink(r.Context(), configIdParam, presenceLinkParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // PutConfigurationById - Updates a configuration
 func (c *ConfigurationAPIController) PutConfigurationById(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
@@ -196,3 +429,24 @@ func (c *ConfigurationAPIController) PutConfigurationById(w http.ResponseWriter,
 	// If no error, encode the body and the result code
 	EncodeJSONResponse(result.Body, &result.Code, w)
 }
+
+// TestConfigurationById - Tests a configuration
+func (c *ConfigurationAPIController) TestConfigurationById(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	configIdParam, err := parseNumericParameter[int64](
+		params["config-id"],
+		WithRequire[int64](parseInt64),
+	)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	result, err := c.service.TestConfigurationById(r.Context(), configIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
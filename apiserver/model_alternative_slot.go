@@ -0,0 +1,43 @@
+/*
+ * Microsoft 365 App
+ *
+ * API to access and configure the Microsoft 365 App
+ *
+ * API version: 1.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+import (
+	"time"
+)
+
+// AlternativeSlot - A free slot offered instead of a conflicting booking
+type AlternativeSlot struct {
+
+	// The ID of the asset of the resource. Missing if the resource has no asset in Eliona.
+	AssetId int32 `json:"assetId,omitempty"`
+
+	// The email address of the resource.
+	Email string `json:"email,omitempty"`
+
+	// The display name of the resource.
+	DisplayName string `json:"displayName,omitempty"`
+
+	// Start of the free slot.
+	Start time.Time `json:"start,omitempty"`
+
+	// End of the free slot.
+	End time.Time `json:"end,omitempty"`
+}
+
+// AssertAlternativeSlotRequired checks if the required fields are not zero-ed
+func AssertAlternativeSlotRequired(obj AlternativeSlot) error {
+	return nil
+}
+
+// AssertAlternativeSlotConstraints checks if the values respects the defined constraints
+func AssertAlternativeSlotConstraints(obj AlternativeSlot) error {
+	return nil
+}
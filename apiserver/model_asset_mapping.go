@@ -0,0 +1,47 @@
+/*
+ * Microsoft 365 App
+ *
+ * API to access and configure the Microsoft 365 App
+ *
+ * API version: 1.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// AssetMapping - Maps a room or equipment to an existing Eliona asset
+type AssetMapping struct {
+
+	// Internal identifier of the mapping (created automatically).
+	Id *int64 `json:"id,omitempty"`
+
+	// Eliona project the asset belongs to
+	ProjectId string `json:"projectId"`
+
+	// Email address of the room or equipment mailbox
+	Email string `json:"email"`
+
+	// ID of the existing Eliona asset
+	AssetId int32 `json:"assetId"`
+}
+
+// AssertAssetMappingRequired checks if the required fields are not zero-ed
+func AssertAssetMappingRequired(obj AssetMapping) error {
+	elements := map[string]interface{}{
+		"projectId": obj.ProjectId,
+		"email":     obj.Email,
+		"assetId":   obj.AssetId,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertAssetMappingConstraints checks if the values respects the defined constraints
+func AssertAssetMappingConstraints(obj AssetMapping) error {
+	return nil
+}
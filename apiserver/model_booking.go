@@ -29,6 +29,9 @@ type Booking struct {
 
 	// The name of the organizer.
 	OrganizerName string `json:"organizerName,omitempty"`
+
+	// How the booked resource responded to the booking. Resource mailboxes respond asynchronously, so new bookings start with `none`.
+	ResponseStatus string `json:"responseStatus,omitempty"`
 }
 
 // AssertBookingRequired checks if the required fields are not zero-ed
@@ -0,0 +1,40 @@
+/*
+ * Microsoft 365 App
+ *
+ * API to access and configure the Microsoft 365 App
+ *
+ * API version: 1.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// BookingConflict - The requested resource is not free at the requested time
+type BookingConflict struct {
+
+	// The periods in which the resource is busy during the requested time.
+	Conflicts []TimeSlot `json:"conflicts,omitempty"`
+
+	// Free slots of the same length in the same resource, or at the same time in other rooms of the same building with at least the same capacity.
+	Alternatives []AlternativeSlot `json:"alternatives,omitempty"`
+}
+
+// AssertBookingConflictRequired checks if the required fields are not zero-ed
+func AssertBookingConflictRequired(obj BookingConflict) error {
+	for _, el := range obj.Conflicts {
+		if err := AssertTimeSlotRequired(el); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.Alternatives {
+		if err := AssertAlternativeSlotRequired(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertBookingConflictConstraints checks if the values respects the defined constraints
+func AssertBookingConflictConstraints(obj BookingConflict) error {
+	return nil
+}
@@ -0,0 +1,55 @@
+/*
+ * Microsoft 365 App
+ *
+ * API to access and configure the Microsoft 365 App
+ *
+ * API version: 1.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+import (
+	"time"
+)
+
+// BookingSession - A device code authorized for managing bookings
+type BookingSession struct {
+
+	// Internal identifier of the session.
+	Id int64 `json:"id,omitempty"`
+
+	// The configuration of the asset the session was created for.
+	ConfigId int64 `json:"configId,omitempty"`
+
+	// The ID of the asset the session was created for.
+	AssetId int32 `json:"assetId,omitempty"`
+
+	// State of the sign-in with the device code.
+	Status string `json:"status,omitempty"`
+
+	// Whether the user has signed in with the device code. Deprecated, use status instead.
+	Authorized bool `json:"authorized,omitempty"`
+
+	// The Microsoft 365 user who signed in with the device code. Empty until the user signs in.
+	UserName string `json:"userName,omitempty"`
+
+	// The display name of the user who signed in with the device code.
+	UserDisplayName string `json:"userDisplayName,omitempty"`
+
+	// When the session was created.
+	CreatedAt time.Time `json:"createdAt,omitempty"`
+
+	// When the session expires.
+	ExpiresAt time.Time `json:"expiresAt,omitempty"`
+}
+
+// AssertBookingSessionRequired checks if the required fields are not zero-ed
+func AssertBookingSessionRequired(obj BookingSession) error {
+	return nil
+}
+
+// AssertBookingSessionConstraints checks if the values respects the defined constraints
+func AssertBookingSessionConstraints(obj BookingSession) error {
+	return nil
+}
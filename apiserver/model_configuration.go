@@ -21,6 +21,12 @@ type Configuration struct {
 	// Client Secret
 	ClientSecret *string `json:"clientSecret,omitempty"`
 
+	// Client certificate with its private key, either PEM encoded or as base64 encoded PFX (PKCS#12). Takes precedence over the client secret and username/password.
+	ClientCertificate *string `json:"clientCertificate,omitempty"`
+
+	// Passphrase of a PFX client certificate, if it is encrypted (encrypted PEM keys are not supported)
+	ClientCertificatePassword *string `json:"clientCertificatePassword,omitempty"`
+
 	// Tenant ID
 	TenantId string `json:"tenantId,omitempty"`
 
@@ -45,14 +51,59 @@ type Configuration struct {
 	// Timeout in seconds
 	RequestTimeout *int32 `json:"requestTimeout,omitempty"`
 
+	// How far into the future the schedules of rooms and equipment are queried, in minutes
+	ScheduleWindow int32 `json:"scheduleWindow,omitempty"`
+
+	// Duration of one slot in the availability view, in minutes (5 to 1440)
+	ScheduleInterval int32 `json:"scheduleInterval,omitempty"`
+
+	// Number of batch requests sent to Microsoft Graph in parallel when discovering rooms and equipment (1 to 16)
+	BatchWorkers int32 `json:"batchWorkers,omitempty"`
+
 	// Array of rules combined by logical OR
 	AssetFilter [][]FilterRule `json:"assetFilter,omitempty"`
 
+	// What happens to assets of rooms and equipment that are removed from Microsoft 365 or no longer pass the asset filter. `inactive` tags the asset as inactive, `archive` moves it under an "Archived" asset, `delete` deletes it. Assets of resources that appear again are restored.
+	StaleAssetPolicy string `json:"staleAssetPolicy,omitempty"`
+
+	// `create` creates assets for the rooms and equipment. `map` writes their data to existing Eliona assets instead: assets mapped explicitly via the asset mappings, or assets whose global asset identifier equals the `mappingProperty` of the room or equipment. Rooms and equipment without a matching asset are skipped.
+	AssetMode string `json:"assetMode,omitempty"`
+
+	// Property of rooms and equipment matched against the global asset identifier of existing Eliona assets in `map` mode, e.g. `email_address` or `display_name`. Possible properties are the same as for the asset filter.
+	MappingProperty *string `json:"mappingProperty,omitempty"`
+
+	// Add the attributes of the app to the asset types of mapped assets, so that the data written by the app shows up there. This changes asset types the app does not own, e.g. those of a BIM import.
+	ExtendAssetTypes *bool `json:"extendAssetTypes,omitempty"`
+
+	// Start of the working hours in the time zone of the configuration (HH:MM). Utilization is calculated against the working hours.
+	WorkingHoursStart string `json:"workingHoursStart,omitempty"`
+
+	// End of the working hours in the time zone of the configuration (HH:MM)
+	WorkingHoursEnd string `json:"workingHoursEnd,omitempty"`
+
+	// Days of the week with working hours
+	WorkingDays []string `json:"workingDays,omitempty"`
+
+	// Minutes after the start of a booking without presence in the room after which the booking is flagged as a no-show. Applies to rooms with a presence link.
+	NoShowTimeout int32 `json:"noShowTimeout,omitempty"`
+
+	// Release rooms of no-show bookings by declining the meeting on behalf of the room
+	NoShowRelease *bool `json:"noShowRelease,omitempty"`
+
+	// `delegated` creates bookings on behalf of users signed in with a device code. `application` creates them with the app credentials of the configuration, in the calendar of `bookingAccount` or of the booked room, and adds the requesting user as an attendee.
+	BookingMode string `json:"bookingMode,omitempty"`
+
+	// Mailbox whose calendar holds the bookings created in `application` mode. If empty, bookings are created in the calendar of the booked room itself.
+	BookingAccount *string `json:"bookingAccount,omitempty"`
+
 	// Set to `true` by the app when running and to `false` when app is stopped
 	Active *bool `json:"active,omitempty"`
 
 	// List of Eliona project ids for which this device should collect data. For each project id all smart devices are automatically created as an asset in Eliona. The mapping to Eliona assets is stored as an asset mapping in the Microsoft 365 app.
 	ProjectIDs *[]string `json:"projectIDs,omitempty"`
+
+	// Time zone of the rooms and equipment, used for schedules and bookings. Accepts both IANA (e.g. `Europe/Zurich`) and Windows (e.g. `W. Europe Standard Time`) names.
+	TimeZone string `json:"timeZone,omitempty"`
 }
 
 // AssertConfigurationRequired checks if the required fields are not zero-ed
@@ -0,0 +1,67 @@
+/*
+ * Microsoft 365 App
+ *
+ * API to access and configure the Microsoft 365 App
+ *
+ * API version: 1.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// ConfigurationDiagnostics - Result of testing a configuration
+type ConfigurationDiagnostics struct {
+
+	// Whether all checks passed
+	Ok bool `json:"ok"`
+
+	// Whether a token could be acquired
+	Authenticated bool `json:"authenticated"`
+
+	// Why the token could not be acquired
+	Error *string `json:"error,omitempty"`
+
+	// Whether the token carries application or delegated permissions
+	PermissionType string `json:"permissionType,omitempty"`
+
+	// Microsoft Graph permissions needed by the app
+	Permissions []PermissionCheck `json:"permissions,omitempty"`
+
+	// Number of rooms currently visible in Microsoft Places with the configuration
+	Rooms *int32 `json:"rooms,omitempty"`
+
+	// Why the rooms could not be listed
+	RoomsError *string `json:"roomsError,omitempty"`
+
+	// Number of rooms found by the last synchronization of the directory. Missing if no synchronization has run yet.
+	SynchronizedRooms *int32 `json:"synchronizedRooms,omitempty"`
+
+	// Number of equipment mailboxes found by the last synchronization of the directory. Missing if no synchronization has run yet.
+	SynchronizedEquipment *int32 `json:"synchronizedEquipment,omitempty"`
+
+	// Rooms and equipment whose calendar could not be read in the last calculation of the utilization KPIs, with the error. Usually the Calendars.Read permission is missing.
+	UnreadableCalendars map[string]string `json:"unreadableCalendars,omitempty"`
+}
+
+// AssertConfigurationDiagnosticsRequired checks if the required fields are not zero-ed
+func AssertConfigurationDiagnosticsRequired(obj ConfigurationDiagnostics) error {
+	elements := map[string]interface{}{
+		"ok":            obj.Ok,
+		"authenticated": obj.Authenticated,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	if err := AssertRecurseInterfaceRequired(obj.Permissions, AssertPermissionCheckRequired); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AssertConfigurationDiagnosticsConstraints checks if the values respects the defined constraints
+func AssertConfigurationDiagnosticsConstraints(obj ConfigurationDiagnostics) error {
+	return nil
+}
@@ -11,8 +11,11 @@ package apiserver
 
 type CreateBookingRequest struct {
 
-	// Device code obtained from authorization
-	DeviceCode string `json:"deviceCode"`
+	// Device code obtained from authorization. Required unless the configuration of the asset uses the `application` booking mode.
+	DeviceCode string `json:"deviceCode,omitempty"`
+
+	// The ID of the asset to book. Required in the `application` booking mode, where it replaces the device code.
+	AssetId string `json:"assetId,omitempty"`
 
 	// The start datetime of the booking in ISO 8601 format.
 	Start string `json:"start"`
@@ -30,9 +33,8 @@ type CreateBookingRequest struct {
 // AssertCreateBookingRequestRequired checks if the required fields are not zero-ed
 func AssertCreateBookingRequestRequired(obj CreateBookingRequest) error {
 	elements := map[string]interface{}{
-		"deviceCode": obj.DeviceCode,
-		"start":      obj.Start,
-		"end":        obj.End,
+		"start": obj.Start,
+		"end":   obj.End,
 	}
 	for name, el := range elements {
 		if isZero := IsZeroValue(el); isZero {
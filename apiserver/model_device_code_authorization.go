@@ -0,0 +1,37 @@
+/*
+ * Microsoft 365 App
+ *
+ * API to access and configure the Microsoft 365 App
+ *
+ * API version: 1.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+import (
+	"time"
+)
+
+// DeviceCodeAuthorization - A device code the user signs in with to authorize managing bookings
+type DeviceCodeAuthorization struct {
+
+	// The code the user enters on the verification page. Used as device code for managing bookings.
+	DeviceCode string `json:"deviceCode,omitempty"`
+
+	// The page where the user enters the code and signs in.
+	VerificationUrl string `json:"verificationUrl,omitempty"`
+
+	// When the code expires if the user does not sign in.
+	ExpiresAt time.Time `json:"expiresAt,omitempty"`
+}
+
+// AssertDeviceCodeAuthorizationRequired checks if the required fields are not zero-ed
+func AssertDeviceCodeAuthorizationRequired(obj DeviceCodeAuthorization) error {
+	return nil
+}
+
+// AssertDeviceCodeAuthorizationConstraints checks if the values respects the defined constraints
+func AssertDeviceCodeAuthorizationConstraints(obj DeviceCodeAuthorization) error {
+	return nil
+}
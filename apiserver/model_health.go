@@ -0,0 +1,50 @@
+/*
+ * Microsoft 365 App
+ *
+ * API to access and configure the Microsoft 365 App
+ *
+ * API version: 1.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// Health - Health of the app
+type Health struct {
+
+	// Whether the app is able to work
+	Status string `json:"status"`
+
+	Database *HealthCheck `json:"database,omitempty"`
+
+	Graph *HealthCheck `json:"graph,omitempty"`
+}
+
+// AssertHealthRequired checks if the required fields are not zero-ed
+func AssertHealthRequired(obj Health) error {
+	elements := map[string]interface{}{
+		"status": obj.Status,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	if obj.Database != nil {
+		if err := AssertHealthCheckRequired(*obj.Database); err != nil {
+			return err
+		}
+	}
+	if obj.Graph != nil {
+		if err := AssertHealthCheckRequired(*obj.Graph); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertHealthConstraints checks if the values respects the defined constraints
+func AssertHealthConstraints(obj Health) error {
+	return nil
+}
@@ -0,0 +1,39 @@
+/*
+ * Microsoft 365 App
+ *
+ * API to access and configure the Microsoft 365 App
+ *
+ * API version: 1.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// HealthCheck - Result of checking a dependency of the app
+type HealthCheck struct {
+
+	// Whether the dependency is reachable
+	Ok bool `json:"ok"`
+
+	// Why the dependency is not reachable
+	Error *string `json:"error,omitempty"`
+}
+
+// AssertHealthCheckRequired checks if the required fields are not zero-ed
+func AssertHealthCheckRequired(obj HealthCheck) error {
+	elements := map[string]interface{}{
+		"ok": obj.Ok,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertHealthCheckConstraints checks if the values respects the defined constraints
+func AssertHealthCheckConstraints(obj HealthCheck) error {
+	return nil
+}
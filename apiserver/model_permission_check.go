@@ -0,0 +1,39 @@
+/*
+ * Microsoft 365 App
+ *
+ * API to access and configure the Microsoft 365 App
+ *
+ * API version: 1.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+type PermissionCheck struct {
+	Permission string `json:"permission"`
+
+	Granted bool `json:"granted"`
+
+	// The granted permission including the needed one, e.g. `Place.ReadWrite.All`
+	GrantedBy *string `json:"grantedBy,omitempty"`
+}
+
+// AssertPermissionCheckRequired checks if the required fields are not zero-ed
+func AssertPermissionCheckRequired(obj PermissionCheck) error {
+	elements := map[string]interface{}{
+		"permission": obj.Permission,
+		"granted":    obj.Granted,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertPermissionCheckConstraints checks if the values respects the defined constraints
+func AssertPermissionCheckConstraints(obj PermissionCheck) error {
+	return nil
+}
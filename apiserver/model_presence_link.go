@@ -0,0 +1,50 @@
+/*
+ * Microsoft 365 App
+ *
+ * API to access and configure the Microsoft 365 App
+ *
+ * API version: 1.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// PresenceLink - Links a room to the presence attribute of an Eliona asset
+type PresenceLink struct {
+
+	// Internal identifier of the link (created automatically).
+	Id *int64 `json:"id,omitempty"`
+
+	// Email address of the room mailbox
+	Email string `json:"email"`
+
+	// ID of the Eliona asset with the presence attribute
+	AssetId int32 `json:"assetId"`
+
+	// Attribute of the asset that is non-zero while presence is detected
+	Attribute string `json:"attribute"`
+
+	// Data subtype of the attribute
+	Subtype string `json:"subtype,omitempty"`
+}
+
+// AssertPresenceLinkRequired checks if the required fields are not zero-ed
+func AssertPresenceLinkRequired(obj PresenceLink) error {
+	elements := map[string]interface{}{
+		"email":     obj.Email,
+		"assetId":   obj.AssetId,
+		"attribute": obj.Attribute,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertPresenceLinkConstraints checks if the values respects the defined constraints
+func AssertPresenceLinkConstraints(obj PresenceLink) error {
+	return nil
+}
@@ -0,0 +1,54 @@
+/*
+ * Microsoft 365 App
+ *
+ * API to access and configure the Microsoft 365 App
+ *
+ * API version: 1.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+import (
+	"time"
+)
+
+// SyncStatus - Synchronization status of a configuration. Fields are missing until the first synchronization.
+type SyncStatus struct {
+
+	// When the last synchronization started
+	LastStartedAt *time.Time `json:"lastStartedAt,omitempty"`
+
+	// When the last successful synchronization finished
+	LastSucceededAt *time.Time `json:"lastSucceededAt,omitempty"`
+
+	// When the last failed synchronization finished
+	LastFailedAt *time.Time `json:"lastFailedAt,omitempty"`
+
+	// Why the last failed synchronization failed
+	LastError *string `json:"lastError,omitempty"`
+
+	// Number of rooms collected by the last successful synchronization
+	Rooms *int32 `json:"rooms,omitempty"`
+
+	// Number of equipment collected by the last successful synchronization
+	Equipment *int32 `json:"equipment,omitempty"`
+
+	// Duration of the last successful synchronization in milliseconds
+	DurationMs *int32 `json:"durationMs,omitempty"`
+
+	Throttling ThrottlingCounters `json:"throttling,omitempty"`
+}
+
+// AssertSyncStatusRequired checks if the required fields are not zero-ed
+func AssertSyncStatusRequired(obj SyncStatus) error {
+	if err := AssertThrottlingCountersRequired(obj.Throttling); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AssertSyncStatusConstraints checks if the values respects the defined constraints
+func AssertSyncStatusConstraints(obj SyncStatus) error {
+	return nil
+}
@@ -0,0 +1,33 @@
+/*
+ * Microsoft 365 App
+ *
+ * API to access and configure the Microsoft 365 App
+ *
+ * API version: 1.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+// ThrottlingCounters - How often Microsoft Graph throttled the requests of the configuration since the app started
+type ThrottlingCounters struct {
+
+	// Responses with status 429 or 503
+	Throttled int64 `json:"throttled,omitempty"`
+
+	// Requests sent again after being throttled
+	Retries int64 `json:"retries,omitempty"`
+
+	// Requests that were still throttled after the last retry
+	Failures int64 `json:"failures,omitempty"`
+}
+
+// AssertThrottlingCountersRequired checks if the required fields are not zero-ed
+func AssertThrottlingCountersRequired(obj ThrottlingCounters) error {
+	return nil
+}
+
+// AssertThrottlingCountersConstraints checks if the values respects the defined constraints
+func AssertThrottlingCountersConstraints(obj ThrottlingCounters) error {
+	return nil
+}
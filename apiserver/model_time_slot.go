@@ -0,0 +1,34 @@
+/*
+ * Microsoft 365 App
+ *
+ * API to access and configure the Microsoft 365 App
+ *
+ * API version: 1.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package apiserver
+
+import (
+	"time"
+)
+
+// TimeSlot - A period of time
+type TimeSlot struct {
+
+	// Start of the period.
+	Start time.Time `json:"start,omitempty"`
+
+	// End of the period.
+	End time.Time `json:"end,omitempty"`
+}
+
+// AssertTimeSlotRequired checks if the required fields are not zero-ed
+func AssertTimeSlotRequired(obj TimeSlot) error {
+	return nil
+}
+
+// AssertTimeSlotConstraints checks if the values respects the defined constraints
+func AssertTimeSlotConstraints(obj TimeSlot) error {
+	return nil
+}
@@ -25,11 +25,13 @@ import (
 	"microsoft-365/msgraph"
 	"net/http"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
 	"github.com/eliona-smart-building-assistant/go-eliona/client"
+	"github.com/eliona-smart-building-assistant/go-eliona/frontend"
 	"github.com/eliona-smart-building-assistant/go-utils/log"
 )
 
@@ -37,36 +39,75 @@ import (
 // This service should implement the business logic for every endpoint for the BookingAPI API.
 // Include any external packages or services that will be required by this service.
 type BookingAPIService struct {
-	sessions map[string]authorizedSession
-	mu       sync.Mutex
+	// graphs caches the clients of booking sessions by device code. The sessions themselves are
+	// stored in the database.
+	graphs map[string]*msgraph.GraphHelper
+	mu     sync.Mutex
 }
 
 type authorizedSession struct {
-	asset   *appdb.Asset
-	graph   *msgraph.GraphHelper
-	created time.Time
+	asset    *appdb.Asset
+	config   *apiserver.Configuration
+	graph    *msgraph.GraphHelper
+	timeZone msgraph.TimeZone
 }
 
-func (s *BookingAPIService) addSession(deviceCode string, session authorizedSession) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+// getSession returns the booking session of the device code, if the user signed in with it. Unknown
+// device codes and those of sessions older than conf.BookingSessionDuration are refused with 400,
+// device codes whose sign-in is still pending with 401 and those whose sign-in failed or expired
+// with 403.
+func (s *BookingAPIService) getSession(ctx context.Context, deviceCode string) (*authorizedSession, apiserver.ImplResponse, error) {
+	// Expired sessions are not returned.
+	dbSession, err := conf.GetBookingSession(ctx, deviceCode)
+	if err != nil {
+		log.Error("conf", "getting booking session: %v", err)
+		return nil, apiserver.Response(http.StatusInternalServerError, nil), fmt.Errorf("internal server error")
+	}
 
-	if s.sessions == nil {
-		s.sessions = make(map[string]authorizedSession)
+	if dbSession == nil {
+		s.mu.Lock()
+		delete(s.graphs, deviceCode)
+		s.mu.Unlock()
+		return nil, apiserver.Response(http.StatusBadRequest, nil), errors.New("invalid device code")
+	}
+	switch dbSession.Status {
+	case conf.BookingSessionAuthorized:
+	case conf.BookingSessionPending:
+		return nil, apiserver.Response(http.StatusUnauthorized, nil), errors.New("sign-in with the device code is not completed yet")
+	default:
+		return nil, apiserver.Response(http.StatusForbidden, nil), fmt.Errorf("sign-in with the device code %s", dbSession.Status)
 	}
-	s.sessions[deviceCode] = session
-}
 
-func (s *BookingAPIService) cleanupSessions() {
-	const sessionDuration = 7 * 24 * time.Hour
+	asset, config, resp, err := fetchDBData(ctx, strconv.Itoa(int(dbSession.AssetID)))
+	if err != nil {
+		return nil, resp, err
+	}
+	tz, err := msgraph.ParseTimeZone(config.TimeZone)
+	if err != nil {
+		log.Error("conf", "parsing time zone of configuration %v: %v", *config.Id, err)
+		return nil, apiserver.Response(http.StatusInternalServerError, nil), fmt.Errorf("internal server error")
+	}
 
 	s.mu.Lock()
 	defer s.mu.Unlock()
-
-	for sessionID, session := range s.sessions {
-		if time.Since(session.created) > sessionDuration {
-			delete(s.sessions, sessionID)
+	graph, ok := s.graphs[deviceCode]
+	if !ok {
+		graph, err = msgraph.NewSessionGraph(*config, deviceCode)
+		if err != nil {
+			log.Error("microsoft-365", "initializing graph for booking session: %v", err)
+			return nil, apiserver.Response(http.StatusInternalServerError, nil), fmt.Errorf("internal server error")
+		}
+		if s.graphs == nil {
+			s.graphs = make(map[string]*msgraph.GraphHelper)
 		}
+		s.graphs[deviceCode] = graph
+	}
+	return &authorizedSession{asset: asset, config: config, graph: graph, timeZone: tz}, apiserver.ImplResponse{}, nil
+}
+
+func (s *BookingAPIService) cleanupSessions() {
+	if err := conf.DeleteExpiredBookingSessions(context.Background()); err != nil {
+		log.Error("conf", "deleting expired booking sessions: %v", err)
 	}
 }
 
@@ -99,48 +140,93 @@ func fetchDBData(ctx context.Context, assetId string) (*appdb.Asset, *apiserver.
 }
 
 // BookingsAuthorizeGet - Authorize user for managing bookings
+//
+// Kept for existing clients, it returns only the device code. See BookingsDeviceCodeGet.
 func (s *BookingAPIService) BookingsAuthorizeGet(ctx context.Context, assetId string) (apiserver.ImplResponse, error) {
-	asset, config, resp, err := fetchDBData(ctx, assetId)
+	authorization, resp, err := s.startDeviceCodeLogin(ctx, assetId)
 	if err != nil {
 		return resp, err
 	}
+	return apiserver.Response(http.StatusOK, authorization.DeviceCode), nil
+}
 
-	graph := msgraph.NewGraphHelper()
-	userCodeChannel := make(chan string)
-	if err := graph.InitializeGraphForUserAuth(config.ClientId, config.TenantId, userCodeChannel); err != nil {
-		log.Error("microsoft-365", "initializing graph for user auth: %v", err)
-		return apiserver.Response(http.StatusInternalServerError, nil), fmt.Errorf("internal server error")
+// BookingsDeviceCodeGet - Start a device code sign-in for managing bookings
+func (s *BookingAPIService) BookingsDeviceCodeGet(ctx context.Context, assetId string) (apiserver.ImplResponse, error) {
+	authorization, resp, err := s.startDeviceCodeLogin(ctx, assetId)
+	if err != nil {
+		return resp, err
+	}
+	return apiserver.Response(http.StatusOK, authorization), nil
+}
+
+// startDeviceCodeLogin starts the sign-in of a user with a device code and stores the session.
+// The sign-in is completed in the background.
+func (s *BookingAPIService) startDeviceCodeLogin(ctx context.Context, assetId string) (apiserver.DeviceCodeAuthorization, apiserver.ImplResponse, error) {
+	var authorization apiserver.DeviceCodeAuthorization
+	asset, config, resp, err := fetchDBData(ctx, assetId)
+	if err != nil {
+		return authorization, resp, err
+	}
+	// The tokens of the user are stored with the session and must not be stored in plaintext.
+	enabled, err := conf.EncryptionEnabled()
+	if err != nil {
+		log.Error("conf", "loading encryption keys: %v", err)
+		return authorization, apiserver.Response(http.StatusInternalServerError, nil), fmt.Errorf("internal server error")
+	}
+	if !enabled {
+		return authorization, apiserver.Response(http.StatusServiceUnavailable, nil), errors.New("bookings with device codes require CONFIG_ENCRYPTION_KEY to be set")
+	}
+
+	login, err := msgraph.StartDeviceCodeLogin(ctx, config.ClientId, config.TenantId)
+	if err != nil {
+		log.Debug("msgraph", "starting device code login: %v", err)
+		return authorization, apiserver.Response(http.StatusBadRequest, nil), fmt.Errorf("testing authorization failed: server responded with error: %v", err)
+	}
+	if err := conf.InsertBookingSession(ctx, *config.Id, asset.AssetID.Int32, login.UserCode, login.ExpiresOn); err != nil {
+		log.Error("conf", "storing booking session: %v", err)
+		return authorization, apiserver.Response(http.StatusInternalServerError, nil), fmt.Errorf("internal server error")
 	}
-	// Ensure this doesn't hang indefinitely waiting for user action
-	ctxTimeout, cancel := context.WithTimeout(ctx, 15*time.Minute)
-	defer cancel()
 
-	errChan := make(chan error)
 	go func() {
-		err := graph.InitiateAuthorization(context.Background())
+		// Stops waiting once the device code expires.
+		waitCtx, cancel := context.WithDeadline(context.Background(), login.ExpiresOn)
+		defer cancel()
+		user, err := login.Wait(waitCtx)
 		if err != nil {
-			log.Debug("msgraph", "testing user request: %v", err)
-			errChan <- err
+			log.Debug("msgraph", "waiting for device code login: %v", err)
+			status := conf.BookingSessionFailed
+			if errors.Is(err, context.DeadlineExceeded) || time.Now().After(login.ExpiresOn) {
+				status = conf.BookingSessionExpired
+			}
+			if err := conf.SetBookingSessionStatus(context.Background(), login.UserCode, status); err != nil {
+				log.Error("conf", "recording status of booking session: %v", err)
+			}
+			return
+		}
+		if err := conf.SetBookingSessionAuthorized(context.Background(), login.UserCode, user.UserName, user.DisplayName); err != nil {
+			log.Error("conf", "recording user of booking session: %v", err)
 		}
 	}()
+	go s.cleanupSessions()
+	authorization = apiserver.DeviceCodeAuthorization{
+		DeviceCode:      login.UserCode,
+		VerificationUrl: login.VerificationURL,
+		ExpiresAt:       login.ExpiresOn,
+	}
+	return authorization, apiserver.ImplResponse{}, nil
+}
 
-	select {
-	case err := <-errChan:
-		if err != nil {
-			return apiserver.Response(http.StatusBadRequest, nil), fmt.Errorf("testing authorization failed: server responded with error: %v", err)
-		}
-	case userCode := <-userCodeChannel:
-		s.addSession(userCode, authorizedSession{
-			asset:   asset,
-			graph:   graph,
-			created: time.Now(),
-		})
-		go s.cleanupSessions()
-		return apiserver.Response(http.StatusOK, userCode), nil
-	case <-ctxTimeout.Done():
-		return apiserver.Response(http.StatusRequestTimeout, nil), errors.New("the code was not authorized before context done")
+// BookingsAuthorizeStatusGet - Get the status of a device code sign-in
+func (s *BookingAPIService) BookingsAuthorizeStatusGet(ctx context.Context, deviceCode string) (apiserver.ImplResponse, error) {
+	session, err := conf.GetBookingSessionStatus(ctx, deviceCode)
+	if err != nil {
+		log.Error("conf", "getting booking session: %v", err)
+		return apiserver.Response(http.StatusInternalServerError, nil), fmt.Errorf("internal server error")
+	}
+	if session == nil {
+		return apiserver.Response(http.StatusNotFound, nil), errors.New("booking session not found")
 	}
-	return apiserver.Response(http.StatusBadRequest, nil), fmt.Errorf("undefined: authorization finished without device code")
+	return apiserver.Response(http.StatusOK, session), nil
 }
 
 // BookingsGet - List bookings
@@ -149,17 +235,19 @@ func (s *BookingAPIService) BookingsGet(ctx context.Context, start, end string,
 	if err != nil {
 		return resp, err
 	}
-	graph := msgraph.NewGraphHelper()
-	if config.ClientSecret == nil || config.Username == nil || config.Password == nil {
-		log.Error("conf", "Shouldn't happen: some values are nil")
+	graph, err := msgraph.GetGraph(*config)
+	if err != nil {
+		log.Error("microsoft-365", "getting graph for configuration %d: %v", *config.Id, err)
 		return apiserver.Response(http.StatusInternalServerError, nil), fmt.Errorf("internal server error")
 	}
-	if err := graph.InitializeGraph(config.ClientId, config.TenantId, *config.ClientSecret, *config.Username, *config.Password); err != nil {
-		log.Error("microsoft-365", "initializing graph for user auth: %v", err)
+
+	tz, err := msgraph.ParseTimeZone(config.TimeZone)
+	if err != nil {
+		log.Error("conf", "parsing time zone of configuration %v: %v", *config.Id, err)
 		return apiserver.Response(http.StatusInternalServerError, nil), fmt.Errorf("internal server error")
 	}
 
-	bookings, err := graph.ListBookings(ctx, asset.Email, start, end)
+	bookings, err := graph.ListBookings(ctx, tz, asset.Email, start, end)
 	if err != nil {
 		log.Error("microsoft-365", "getting events from MS Graph: %v", err)
 		return apiserver.Response(http.StatusInternalServerError, nil), fmt.Errorf("internal server error")
@@ -169,24 +257,136 @@ func (s *BookingAPIService) BookingsGet(ctx context.Context, start, end string,
 
 // BookingsPost - Create a booking
 func (s *BookingAPIService) BookingsPost(ctx context.Context, createBookingRequest apiserver.CreateBookingRequest) (apiserver.ImplResponse, error) {
-	session, ok := s.sessions[createBookingRequest.DeviceCode]
-	if !ok {
-		return apiserver.Response(http.StatusBadRequest, nil), errors.New("invalid device code")
+	if createBookingRequest.DeviceCode == "" {
+		return s.createApplicationBooking(ctx, createBookingRequest)
+	}
+	session, resp, err := s.getSession(ctx, createBookingRequest.DeviceCode)
+	if err != nil {
+		return resp, err
 	}
 
-	if err := session.graph.CreateBooking(ctx, createBookingRequest.Start, createBookingRequest.End, session.asset.Email, createBookingRequest.EventName, createBookingRequest.EventName); err != nil {
+	if resp, err := checkConflicts(ctx, session.graph, *session.config, session.timeZone, session.asset, createBookingRequest); resp.Code != 0 || err != nil {
+		return resp, err
+	}
+	booking, err := session.graph.CreateBooking(ctx, session.timeZone, createBookingRequest.Start, createBookingRequest.End, session.asset.Email, createBookingRequest.EventName, createBookingRequest.EventName)
+	if err != nil {
 		log.Error("microsoft-365", "creating event: %v", err)
 		return apiserver.Response(http.StatusBadRequest, nil), fmt.Errorf("server responded with error: %v", err)
 	}
 
-	return apiserver.Response(http.StatusOK, nil), nil
+	return apiserver.Response(http.StatusCreated, booking), nil
+}
+
+// createApplicationBooking creates the booking with the app credentials of the configuration of
+// the asset, if the configuration allows it.
+func (s *BookingAPIService) createApplicationBooking(ctx context.Context, createBookingRequest apiserver.CreateBookingRequest) (apiserver.ImplResponse, error) {
+	if createBookingRequest.AssetId == "" {
+		return apiserver.Response(http.StatusBadRequest, nil), errors.New("either device code or asset ID is required")
+	}
+	asset, config, resp, err := fetchDBData(ctx, createBookingRequest.AssetId)
+	if err != nil {
+		return resp, err
+	}
+	if config.BookingMode != msgraph.BookingModeApplication {
+		return apiserver.Response(http.StatusBadRequest, nil), fmt.Errorf("configuration %d requires a device code for bookings", *config.Id)
+	}
+	userEmail, resp, err := requestingUser(ctx)
+	if err != nil {
+		return resp, err
+	}
+	graph, err := msgraph.GetGraph(*config)
+	if err != nil {
+		log.Error("microsoft-365", "getting graph for configuration %d: %v", *config.Id, err)
+		return apiserver.Response(http.StatusInternalServerError, nil), fmt.Errorf("internal server error")
+	}
+	if resp, err := checkRequestingUser(ctx, graph, *config, userEmail); err != nil {
+		return resp, err
+	}
+	tz, err := msgraph.ParseTimeZone(config.TimeZone)
+	if err != nil {
+		log.Error("conf", "parsing time zone of configuration %v: %v", *config.Id, err)
+		return apiserver.Response(http.StatusInternalServerError, nil), fmt.Errorf("internal server error")
+	}
+
+	calendar := asset.Email
+	if config.BookingAccount != nil && *config.BookingAccount != "" {
+		calendar = *config.BookingAccount
+	}
+	if resp, err := checkConflicts(ctx, graph, *config, tz, asset, createBookingRequest); resp.Code != 0 || err != nil {
+		return resp, err
+	}
+	booking, err := graph.CreateBookingForUser(ctx, tz, createBookingRequest.Start, createBookingRequest.End, calendar, asset.Email, userEmail, createBookingRequest.EventName, createBookingRequest.Description)
+	if err != nil {
+		log.Error("microsoft-365", "creating event: %v", err)
+		return apiserver.Response(http.StatusBadRequest, nil), fmt.Errorf("server responded with error: %v", err)
+	}
+
+	return apiserver.Response(http.StatusCreated, booking), nil
+}
+
+// requestingUser returns the email address of the Eliona user who sent the request, as given by the
+// Eliona token of the request.
+func requestingUser(ctx context.Context) (string, apiserver.ImplResponse, error) {
+	env := frontend.GetEnvironment(ctx)
+	if env == nil || env.UserId == "" {
+		return "", apiserver.Response(http.StatusUnauthorized, nil), errors.New("bookings without device code require a signed in Eliona user")
+	}
+	user, _, err := client.NewClient().UsersAPI.GetUserById(client.AuthenticationContext(), env.UserId).Execute()
+	if err != nil {
+		log.Error("eliona", "getting Eliona user %s: %v", env.UserId, err)
+		return "", apiserver.Response(http.StatusInternalServerError, nil), fmt.Errorf("internal server error")
+	}
+	return user.Email, apiserver.ImplResponse{}, nil
+}
+
+// checkRequestingUser makes sure that bookings made with the credentials of the app name a
+// requester who is a person in the Microsoft 365 tenant.
+func checkRequestingUser(ctx context.Context, graph *msgraph.GraphHelper, config apiserver.Configuration, email string) (apiserver.ImplResponse, error) {
+	tenantUser, err := graph.IsTenantUser(ctx, config, email)
+	if err != nil {
+		log.Error("microsoft-365", "checking user %s: %v", email, err)
+		return apiserver.Response(http.StatusInternalServerError, nil), fmt.Errorf("internal server error")
+	}
+	if !tenantUser {
+		return apiserver.Response(http.StatusForbidden, nil), fmt.Errorf("%s is not a user of the Microsoft 365 tenant", email)
+	}
+	return apiserver.ImplResponse{}, nil
+}
+
+// checkConflicts responds with 409 and alternatives to the booking if the asset is not free at the
+// requested time. It returns an empty response if the booking can be created.
+func checkConflicts(ctx context.Context, graph *msgraph.GraphHelper, config apiserver.Configuration, tz msgraph.TimeZone, asset *appdb.Asset, createBookingRequest apiserver.CreateBookingRequest) (apiserver.ImplResponse, error) {
+	conflict, err := graph.FindBookingConflict(ctx, config, tz, createBookingRequest.Start, createBookingRequest.End, asset.Email)
+	if err != nil {
+		log.Error("microsoft-365", "checking availability of %s: %v", asset.Email, err)
+		return apiserver.Response(http.StatusInternalServerError, nil), fmt.Errorf("internal server error")
+	}
+	if conflict == nil {
+		return apiserver.ImplResponse{}, nil
+	}
+
+	assets, err := conf.GetAssets(ctx, config)
+	if err != nil {
+		log.Error("conf", "getting assets of configuration %v: %v", *config.Id, err)
+		return apiserver.Response(http.StatusInternalServerError, nil), fmt.Errorf("internal server error")
+	}
+	assetIDs := make(map[string]int32)
+	for _, a := range assets {
+		if a.ProjectID == asset.ProjectID && a.AssetID.Valid {
+			assetIDs[strings.ToLower(a.Email)] = a.AssetID.Int32
+		}
+	}
+	for i, alternative := range conflict.Alternatives {
+		conflict.Alternatives[i].AssetId = assetIDs[strings.ToLower(alternative.Email)]
+	}
+	return apiserver.Response(http.StatusConflict, conflict), nil
 }
 
 // BookingsBookingIdDeletePost - Cancel a booking
 func (s *BookingAPIService) BookingsBookingIdDeletePost(ctx context.Context, bookingId string, deleteBookingRequest apiserver.DeleteBookingRequest) (apiserver.ImplResponse, error) {
-	session, ok := s.sessions[deleteBookingRequest.DeviceCode]
-	if !ok {
-		return apiserver.Response(http.StatusBadRequest, nil), errors.New("invalid device code")
+	session, resp, err := s.getSession(ctx, deleteBookingRequest.DeviceCode)
+	if err != nil {
+		return resp, err
 	}
 
 	if err := session.graph.DeleteBooking(ctx, bookingId); err != nil {
@@ -222,3 +422,24 @@ func (s *BookingAPIService) BookingsBookingIdRegisterGuestPost(ctx context.Conte
 
 	return apiserver.Response(http.StatusOK, nil), nil
 }
+
+// BookingsSessionsGet - List booking sessions
+func (s *BookingAPIService) BookingsSessionsGet(ctx context.Context) (apiserver.ImplResponse, error) {
+	sessions, err := conf.GetBookingSessions(ctx)
+	if err != nil {
+		return apiserver.Response(http.StatusInternalServerError, nil), err
+	}
+	return apiserver.Response(http.StatusOK, sessions), nil
+}
+
+// BookingsSessionsSessionIdDelete - Revoke a booking session
+func (s *BookingAPIService) BookingsSessionsSessionIdDelete(ctx context.Context, sessionId int64) (apiserver.ImplResponse, error) {
+	err := conf.DeleteBookingSession(ctx, sessionId)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.Response(http.StatusNotFound, nil), nil
+	}
+	if err != nil {
+		return apiserver.Response(http.StatusInternalServerError, nil), err
+	}
+	return apiserver.Response(http.StatusNoContent, nil), nil
+}
@@ -18,9 +18,16 @@ package apiservices
 import (
 	"context"
 	"errors"
+	"fmt"
 	"microsoft-365/apiserver"
 	"microsoft-365/conf"
+	"microsoft-365/eliona"
+	"microsoft-365/msgraph"
 	"net/http"
+	"strings"
+
+	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
+	"github.com/eliona-smart-building-assistant/go-utils/common"
 )
 
 // ConfigurationApiService is a service that implements the logic for the ConfigurationApiServicer
@@ -39,14 +46,21 @@ func (s *ConfigurationApiService) GetConfigurations(ctx context.Context) (apiser
 	if err != nil {
 		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
 	}
+	for i := range configs {
+		maskSecrets(&configs[i])
+	}
 	return apiserver.Response(http.StatusOK, configs), nil
 }
 
 func (s *ConfigurationApiService) PostConfiguration(ctx context.Context, config apiserver.Configuration) (apiserver.ImplResponse, error) {
+	if err := validateConfig(&config); err != nil {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, err
+	}
 	insertedConfig, err := conf.InsertConfig(ctx, config)
 	if err != nil {
 		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
 	}
+	maskSecrets(&insertedConfig)
 	return apiserver.Response(http.StatusCreated, insertedConfig), nil
 }
 
@@ -58,20 +72,162 @@ func (s *ConfigurationApiService) GetConfigurationById(ctx context.Context, conf
 	if err != nil {
 		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
 	}
+	maskSecrets(config)
 	return apiserver.Response(http.StatusOK, config), nil
 }
 
+func (s *ConfigurationApiService) GetConfigurationStatusById(ctx context.Context, configId int64) (apiserver.ImplResponse, error) {
+	config, err := conf.GetConfig(ctx, configId)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	status, err := conf.GetSyncStatus(ctx, *config)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	counters := msgraph.GetThrottlingCounters(configId)
+	status.Throttling = apiserver.ThrottlingCounters{
+		Throttled: counters.Throttled,
+		Retries:   counters.Retries,
+		Failures:  counters.Failures,
+	}
+	return apiserver.Response(http.StatusOK, status), nil
+}
+
 func (s *ConfigurationApiService) PutConfigurationById(ctx context.Context, configId int64, config apiserver.Configuration) (apiserver.ImplResponse, error) {
 	config.Id = &configId
+	if err := keepMaskedSecrets(ctx, &config); err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	if err := validateConfig(&config); err != nil {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, err
+	}
 	upsertedConfig, err := conf.UpsertConfig(ctx, config)
 	if err != nil {
 		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
 	}
+	msgraph.InvalidateGraph(configId)
+	maskSecrets(&upsertedConfig)
 	return apiserver.Response(http.StatusCreated, upsertedConfig), nil
 }
 
 func (s *ConfigurationApiService) DeleteConfigurationById(ctx context.Context, configId int64) (apiserver.ImplResponse, error) {
 	err := conf.DeleteConfig(ctx, configId)
+	msgraph.InvalidateGraph(configId)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.ImplResponse{Code: http.StatusNoContent}, nil
+}
+
+func (s *ConfigurationApiService) TestConfigurationById(ctx context.Context, configId int64) (apiserver.ImplResponse, error) {
+	config, err := conf.GetConfig(ctx, configId)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	graph, err := msgraph.GetGraph(*config)
+	if err != nil {
+		report := apiserver.ConfigurationDiagnostics{Error: common.Ptr(err.Error())}
+		return apiserver.Response(http.StatusOK, report), nil
+	}
+	return apiserver.Response(http.StatusOK, graph.Diagnose(ctx, *config)), nil
+}
+
+func (s *ConfigurationApiService) GetAssetMappings(ctx context.Context, configId int64) (apiserver.ImplResponse, error) {
+	if _, err := conf.GetConfig(ctx, configId); errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	} else if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	mappings, err := conf.GetAssetMappings(ctx, configId)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.Response(http.StatusOK, mappings), nil
+}
+
+func (s *ConfigurationApiService) PostAssetMapping(ctx context.Context, configId int64, mapping apiserver.AssetMapping) (apiserver.ImplResponse, error) {
+	if _, err := conf.GetConfig(ctx, configId); errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	} else if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	insertedMapping, err := conf.UpsertAssetMapping(ctx, configId, mapping)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.Response(http.StatusCreated, insertedMapping), nil
+}
+
+func (s *ConfigurationApiService) DeleteAssetMappingById(ctx context.Context, configId int64, mappingId int64) (apiserver.ImplResponse, error) {
+	err := conf.DeleteAssetMapping(ctx, configId, mappingId)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	}
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.ImplResponse{Code: http.StatusNoContent}, nil
+}
+
+func (s *ConfigurationApiService) GetPresenceLinks(ctx context.Context, configId int64) (apiserver.ImplResponse, error) {
+	if _, err := conf.GetConfig(ctx, configId); errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	} else if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	links, err := conf.GetPresenceLinks(ctx, configId)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.Response(http.StatusOK, links), nil
+}
+
+func (s *ConfigurationApiService) PostPresenceLink(ctx context.Context, configId int64, link apiserver.PresenceLink) (apiserver.ImplResponse, error) {
+	config, err := conf.GetConfig(ctx, configId)
+	if errors.Is(err, conf.ErrBadRequest) {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
+	} else if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	if link.AssetId <= 0 {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, fmt.Errorf("assetId is required")
+	}
+	if strings.TrimSpace(link.Attribute) == "" {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, fmt.Errorf("attribute is required")
+	}
+	known, err := msgraph.IsKnownRoom(ctx, *config, link.Email)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	if !known {
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, fmt.Errorf("%s is not a known room", link.Email)
+	}
+	switch api.DataSubtype(link.Subtype) {
+	case "":
+		link.Subtype = string(api.SUBTYPE_INPUT)
+	case api.SUBTYPE_INPUT, api.SUBTYPE_INFO, api.SUBTYPE_STATUS, api.SUBTYPE_OUTPUT, api.SUBTYPE_PROPERTY:
+	default:
+		return apiserver.ImplResponse{Code: http.StatusBadRequest}, fmt.Errorf("unknown subtype %q", link.Subtype)
+	}
+	insertedLink, err := conf.UpsertPresenceLink(ctx, configId, link)
+	if err != nil {
+		return apiserver.ImplResponse{Code: http.StatusInternalServerError}, err
+	}
+	return apiserver.Response(http.StatusCreated, insertedLink), nil
+}
+
+func (s *ConfigurationApiService) DeletePresenceLinkById(ctx context.Context, configId int64, linkId int64) (apiserver.ImplResponse, error) {
+	err := conf.DeletePresenceLink(ctx, configId, linkId)
 	if errors.Is(err, conf.ErrBadRequest) {
 		return apiserver.ImplResponse{Code: http.StatusBadRequest}, nil
 	}
@@ -80,3 +236,131 @@ func (s *ConfigurationApiService) DeleteConfigurationById(ctx context.Context, c
 	}
 	return apiserver.ImplResponse{Code: http.StatusNoContent}, nil
 }
+
+// validateConfig checks the values that would otherwise fail only later during collection and
+// fills in the defaults.
+func validateConfig(config *apiserver.Configuration) error {
+	if config.ClientCertificate != nil && *config.ClientCertificate != "" {
+		var password string
+		if config.ClientCertificatePassword != nil {
+			password = *config.ClientCertificatePassword
+		}
+		if err := msgraph.ValidateCertificate(*config.ClientCertificate, password); err != nil {
+			return fmt.Errorf("invalid client certificate: %v", err)
+		}
+	}
+	if config.TimeZone == "" {
+		config.TimeZone = msgraph.DefaultTimeZone
+	}
+	if err := msgraph.ValidateTimeZone(config.TimeZone); err != nil {
+		return fmt.Errorf("invalid time zone: %v", err)
+	}
+	if config.ScheduleWindow == 0 {
+		config.ScheduleWindow = msgraph.DefaultScheduleWindow
+	}
+	if config.ScheduleInterval == 0 {
+		config.ScheduleInterval = msgraph.DefaultScheduleInterval
+	}
+	if err := msgraph.ValidateScheduleOptions(config.ScheduleWindow, config.ScheduleInterval); err != nil {
+		return fmt.Errorf("invalid schedule options: %v", err)
+	}
+	if config.BatchWorkers == 0 {
+		config.BatchWorkers = msgraph.DefaultBatchWorkers
+	}
+	if config.BatchWorkers < 1 || config.BatchWorkers > msgraph.MaxBatchWorkers {
+		return fmt.Errorf("batch workers must be between 1 and %d, is %d", msgraph.MaxBatchWorkers, config.BatchWorkers)
+	}
+	if config.WorkingHoursStart == "" {
+		config.WorkingHoursStart = msgraph.DefaultWorkingHoursStart
+	}
+	if config.WorkingHoursEnd == "" {
+		config.WorkingHoursEnd = msgraph.DefaultWorkingHoursEnd
+	}
+	if config.WorkingDays == nil {
+		config.WorkingDays = msgraph.DefaultWorkingDays
+	}
+	if err := msgraph.ValidateWorkingHours(config.WorkingHoursStart, config.WorkingHoursEnd, config.WorkingDays); err != nil {
+		return fmt.Errorf("invalid working hours: %v", err)
+	}
+	if config.NoShowTimeout == 0 {
+		config.NoShowTimeout = eliona.DefaultNoShowTimeout
+	}
+	if config.NoShowTimeout < 1 {
+		return fmt.Errorf("no-show timeout must be at least 1 minute, is %d", config.NoShowTimeout)
+	}
+	switch config.StaleAssetPolicy {
+	case "":
+		config.StaleAssetPolicy = eliona.StaleAssetPolicyInactive
+	case eliona.StaleAssetPolicyInactive, eliona.StaleAssetPolicyArchive, eliona.StaleAssetPolicyDelete:
+	default:
+		return fmt.Errorf("unknown stale asset policy %q", config.StaleAssetPolicy)
+	}
+	switch config.AssetMode {
+	case "":
+		config.AssetMode = eliona.AssetModeCreate
+	case eliona.AssetModeCreate, eliona.AssetModeMap:
+	default:
+		return fmt.Errorf("unknown asset mode %q", config.AssetMode)
+	}
+	switch config.BookingMode {
+	case "":
+		config.BookingMode = msgraph.BookingModeDelegated
+	case msgraph.BookingModeDelegated:
+	case msgraph.BookingModeApplication:
+		// Username and password credentials grant only delegated permissions.
+		if (config.ClientCertificate == nil || *config.ClientCertificate == "") && config.Username != nil && *config.Username != "" {
+			return fmt.Errorf("booking mode %q requires a client secret or certificate", config.BookingMode)
+		}
+	default:
+		return fmt.Errorf("unknown booking mode %q", config.BookingMode)
+	}
+	return nil
+}
+
+// secretMask replaces secrets in API responses. Sending it back keeps the stored secret.
+const secretMask = "********"
+
+func secretFields(config *apiserver.Configuration) []*string {
+	return []*string{
+		config.ClientSecret,
+		config.Password,
+		config.ClientCertificate,
+		config.ClientCertificatePassword,
+	}
+}
+
+func maskSecrets(config *apiserver.Configuration) {
+	for _, secret := range secretFields(config) {
+		if secret != nil && *secret != "" {
+			*secret = secretMask
+		}
+	}
+}
+
+// keepMaskedSecrets replaces masked secrets with the stored ones.
+func keepMaskedSecrets(ctx context.Context, config *apiserver.Configuration) error {
+	masked := false
+	for _, secret := range secretFields(config) {
+		masked = masked || (secret != nil && *secret == secretMask)
+	}
+	if !masked {
+		return nil
+	}
+	stored, err := conf.GetConfig(ctx, *config.Id)
+	if errors.Is(err, conf.ErrBadRequest) {
+		stored = &apiserver.Configuration{}
+	} else if err != nil {
+		return fmt.Errorf("getting stored config: %v", err)
+	}
+	storedSecrets := secretFields(stored)
+	for i, secret := range secretFields(config) {
+		if secret == nil || *secret != secretMask {
+			continue
+		}
+		*secret = ""
+		if storedSecrets[i] != nil {
+			*secret = *storedSecrets[i]
+		}
+	}
+	return nil
+}
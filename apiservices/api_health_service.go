@@ -0,0 +1,66 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package apiservices
+
+import (
+	"context"
+	"microsoft-365/apiserver"
+	"microsoft-365/conf"
+	"microsoft-365/msgraph"
+	"net/http"
+	"time"
+
+	"github.com/eliona-smart-building-assistant/go-utils/common"
+)
+
+// HealthApiService is a service that implements the logic for the HealthApiServicer
+// This service should implement the business logic for every endpoint for the HealthApi API.
+// Include any external packages or services that will be required by this service.
+type HealthApiService struct {
+}
+
+// NewHealthApiService creates a default api service
+func NewHealthApiService() apiserver.HealthAPIServicer {
+	return &HealthApiService{}
+}
+
+// GetLiveness - Liveness of the app
+func (s *HealthApiService) GetLiveness(ctx context.Context) (apiserver.ImplResponse, error) {
+	return apiserver.Response(http.StatusOK, apiserver.Health{Status: "up"}), nil
+}
+
+// GetReadiness - Readiness of the app
+func (s *HealthApiService) GetReadiness(ctx context.Context) (apiserver.ImplResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	health := apiserver.Health{
+		Status:   "up",
+		Database: healthCheck(conf.PingDatabase(ctx)),
+		Graph:    healthCheck(msgraph.CheckReachability(ctx)),
+	}
+	if !health.Database.Ok || !health.Graph.Ok {
+		health.Status = "down"
+		return apiserver.Response(http.StatusServiceUnavailable, health), nil
+	}
+	return apiserver.Response(http.StatusOK, health), nil
+}
+
+func healthCheck(err error) *apiserver.HealthCheck {
+	if err != nil {
+		return &apiserver.HealthCheck{Ok: false, Error: common.Ptr(err.Error())}
+	}
+	return &apiserver.HealthCheck{Ok: true}
+}
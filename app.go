@@ -17,6 +17,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"microsoft-365/apiserver"
 	"microsoft-365/apiservices"
@@ -29,6 +30,7 @@ import (
 
 	"github.com/eliona-smart-building-assistant/go-eliona/app"
 	"github.com/eliona-smart-building-assistant/go-eliona/asset"
+	"github.com/eliona-smart-building-assistant/go-eliona/frontend"
 	"github.com/eliona-smart-building-assistant/go-utils/common"
 	"github.com/eliona-smart-building-assistant/go-utils/db"
 	utilshttp "github.com/eliona-smart-building-assistant/go-utils/http"
@@ -38,6 +40,17 @@ import (
 
 var once sync.Once
 
+// Reading the configs is retried with a growing delay while the database is not available.
+const (
+	baseConfigsRetryDelay = time.Second
+	maxConfigsRetryDelay  = time.Minute
+)
+
+var configsRetry struct {
+	failures  int
+	nextRetry time.Time
+}
+
 func initialize() {
 	ctx := context.Background()
 
@@ -50,15 +63,34 @@ func initialize() {
 		app.ExecSqlFile("conf/init.sql"),
 		asset.InitAssetTypeFiles("eliona/asset-type-*.json"),
 	)
+
+	app.Patch(conn, app.AppName(), "010200",
+		app.ExecSqlFile("conf/v1.2.0.sql"),
+		asset.InitAssetTypeFiles("eliona/asset-type-*.json"),
+	)
+
+	if err := conf.EncryptStoredSecrets(ctx); err != nil {
+		log.Error("conf", "encrypting stored secrets: %v", err)
+	}
 }
 
 // collectData is the main app function which is called periodically
 func collectData() {
+	if time.Now().Before(configsRetry.nextRetry) {
+		return
+	}
 	configs, err := conf.GetConfigsForEliona(context.Background())
 	if err != nil {
-		log.Fatal("conf", "Couldn't read configs from DB: %v", err)
+		delay := min(baseConfigsRetryDelay<<configsRetry.failures, maxConfigsRetryDelay)
+		configsRetry.failures = min(configsRetry.failures+1, 16)
+		configsRetry.nextRetry = time.Now().Add(delay)
+		log.Error("conf", "Couldn't read configs from DB, retrying in %v: %v", delay, err)
 		return
 	}
+	if configsRetry.failures > 0 {
+		log.Info("conf", "Reading configs from DB recovered.")
+		configsRetry.failures = 0
+	}
 	if len(configs) == 0 {
 		once.Do(func() {
 			log.Info("conf", "No configs in DB. Please configure the app in Eliona.")
@@ -91,8 +123,20 @@ func collectData() {
 		common.RunOnceWithParam(func(config apiserver.Configuration) {
 			log.Info("main", "Collecting %d started", *config.Id)
 
-			if err := collectResources(config); err != nil {
-				return // Error is handled in the method itself.
+			startedAt := time.Now()
+			if err := conf.SetSyncStarted(context.Background(), config, startedAt); err != nil {
+				log.Error("conf", "recording sync start of configuration %d: %v", *config.Id, err)
+			}
+			rooms, equipment, err := collectResources(config)
+			if err != nil {
+				// Error is logged in the method itself.
+				if err := conf.SetSyncFailed(context.Background(), config, err); err != nil {
+					log.Error("conf", "recording sync failure of configuration %d: %v", *config.Id, err)
+				}
+				return
+			}
+			if err := conf.SetSyncSucceeded(context.Background(), config, rooms, equipment, time.Since(startedAt)); err != nil {
+				log.Error("conf", "recording sync success of configuration %d: %v", *config.Id, err)
 			}
 
 			log.Info("main", "Collecting %d finished", *config.Id)
@@ -102,26 +146,41 @@ func collectData() {
 	}
 }
 
-func collectResources(config apiserver.Configuration) error {
-	graph := msgraph.NewGraphHelper()
-	if config.ClientSecret == nil || config.Username == nil || config.Password == nil {
-		log.Error("conf", "Shouldn't happen: some values are nil")
-		return fmt.Errorf("shouldn't happen: some values are nil")
+// collectResources synchronizes the rooms and equipment of the configuration with Eliona and
+// returns how many of them were collected.
+func collectResources(config apiserver.Configuration) (int, int, error) {
+	graph, err := msgraph.GetGraph(config)
+	if err != nil {
+		log.Error("microsoft-365", "getting graph for configuration %d: %v", *config.Id, err)
+		return 0, 0, fmt.Errorf("getting graph: %v", err)
 	}
-	if err := graph.InitializeGraph(config.ClientId, config.TenantId, *config.ClientSecret, *config.Username, *config.Password); err != nil {
-		log.Error("microsoft-365", "initializing graph for user auth: %v", err)
-		return err
+
+	// Resources missing after an incomplete synchronization are not handled as removed.
+	completeSync := true
+	if err := graph.SyncDirectory(config); errors.Is(err, msgraph.ErrIncompleteSync) {
+		log.Error("microsoft-365", "synchronizing directory: %v", err)
+		completeSync = false
+	} else if err != nil {
+		log.Error("microsoft-365", "synchronizing directory: %v", err)
+		return 0, 0, fmt.Errorf("synchronizing directory: %v", err)
 	}
 
 	rooms, err := graph.GetRooms(config)
 	if err != nil {
 		log.Error("microsoft-365", "getting rooms: %v", err)
-		return err
+		return 0, 0, fmt.Errorf("getting rooms: %v", err)
 	}
 	fmt.Printf("got %v rooms.\n", len(rooms))
-	if err := eliona.CreateRoomsAssetsIfNecessary(config, rooms); err != nil {
-		log.Error("eliona", "creating room assets: %v", err)
-		return err
+
+	roomLists, err := graph.GetRoomLists(config)
+	if err != nil {
+		log.Error("microsoft-365", "getting room lists: %v", err)
+		return 0, 0, fmt.Errorf("getting room lists: %v", err)
+	}
+
+	if err := eliona.DetectNoShows(config, graph, rooms); err != nil {
+		// No-shows are optional, the rest of the data is still valid.
+		log.Error("eliona", "detecting no-shows: %v", err)
 	}
 
 	assets := make([]eliona.Asset, len(rooms))
@@ -132,23 +191,84 @@ func collectResources(config apiserver.Configuration) error {
 	equipment, err := graph.GetEquipment(config)
 	if err != nil {
 		log.Error("microsoft-365", "getting equipment: %v", err)
-		return err
+		return 0, 0, fmt.Errorf("getting equipment: %v", err)
 	}
 	fmt.Printf("got %v equipment.\n", len(equipment))
-	if err := eliona.CreateEquipmentAssetsIfNecessary(config, equipment); err != nil {
-		log.Error("eliona", "creating equipment assets: %v", err)
-		return err
+
+	// Like in SyncDirectory, rooms and equipment get their data even without workspaces and desks.
+	workspaces, err := graph.GetWorkspaces(config)
+	if err != nil {
+		log.Error("microsoft-365", "getting workspaces: %v", err)
+		completeSync = false
+	}
+	log.Debug("microsoft-365", "Got %d workspaces.", len(workspaces))
+
+	desks, err := graph.GetDesks(config)
+	if err != nil {
+		log.Error("microsoft-365", "getting desks: %v", err)
+		completeSync = false
+	}
+	log.Debug("microsoft-365", "Got %d desks.", len(desks))
+
+	if config.AssetMode == eliona.AssetModeMap {
+		if err := eliona.MapAssets(config, rooms, equipment, workspaces, desks); err != nil {
+			log.Error("eliona", "mapping assets: %v", err)
+			return 0, 0, fmt.Errorf("mapping assets: %v", err)
+		}
+	} else {
+		if err := eliona.CreateRoomsAssetsIfNecessary(config, rooms, roomLists); err != nil {
+			log.Error("eliona", "creating room assets: %v", err)
+			return 0, 0, fmt.Errorf("creating room assets: %v", err)
+		}
+		if err := eliona.CreateEquipmentAssetsIfNecessary(config, equipment); err != nil {
+			log.Error("eliona", "creating equipment assets: %v", err)
+			return 0, 0, fmt.Errorf("creating equipment assets: %v", err)
+		}
+		if err := eliona.CreateWorkspaceAssetsIfNecessary(config, workspaces); err != nil {
+			log.Error("eliona", "creating workspace assets: %v", err)
+			return 0, 0, fmt.Errorf("creating workspace assets: %v", err)
+		}
+		if err := eliona.CreateDeskAssetsIfNecessary(config, desks); err != nil {
+			log.Error("eliona", "creating desk assets: %v", err)
+			return 0, 0, fmt.Errorf("creating desk assets: %v", err)
+		}
 	}
 
 	for _, v := range equipment {
 		assets = append(assets, v)
 	}
+	for _, v := range workspaces {
+		assets = append(assets, v)
+	}
+	for _, v := range desks {
+		assets = append(assets, v)
+	}
 
 	if err := eliona.UpsertAssetData(config, assets); err != nil {
 		log.Error("eliona", "inserting room data into Eliona: %v", err)
-		return err
+		return 0, 0, fmt.Errorf("inserting room data into Eliona: %v", err)
+	}
+
+	// Like no-shows, the utilization is optional, the rest of the data is still valid.
+	if utilization, err := graph.GetUtilization(config, rooms, equipment); err != nil {
+		log.Error("microsoft-365", "calculating utilization: %v", err)
+	} else {
+		utilizationAssets := make([]eliona.Asset, len(utilization))
+		for i, v := range utilization {
+			utilizationAssets[i] = v
+		}
+		if err := eliona.UpsertAssetData(config, utilizationAssets); err != nil {
+			log.Error("eliona", "inserting utilization data into Eliona: %v", err)
+		}
+	}
+
+	if !completeSync {
+		log.Warn("eliona", "Assets of removed resources of configuration %d not handled after incomplete synchronization.", *config.Id)
+	} else if err := eliona.HandleStaleAssets(config, assets); err != nil {
+		log.Error("eliona", "handling assets of removed resources: %v", err)
+		return 0, 0, fmt.Errorf("handling assets of removed resources: %v", err)
 	}
-	return nil
+	return len(rooms), len(equipment), nil
 }
 
 // listenApi starts the API server and listen for requests
@@ -157,12 +277,14 @@ func listenApi() {
 	msproxyUrl := "/v1/msproxy/"
 	r.PathPrefix(msproxyUrl).Handler(http.StripPrefix(msproxyUrl, &msgraph.Proxy{}))
 
-	r.PathPrefix("/").Handler(utilshttp.NewCORSEnabledHandler(apiserver.NewRouter(
+	// The environment carries the Eliona user of requests, needed for bookings made by the app.
+	r.PathPrefix("/").Handler(utilshttp.NewCORSEnabledHandler(frontend.NewEnvironmentHandler(apiserver.NewRouter(
 		apiserver.NewConfigurationAPIController(apiservices.NewConfigurationApiService()),
 		apiserver.NewVersionAPIController(apiservices.NewVersionApiService()),
 		apiserver.NewCustomizationAPIController(apiservices.NewCustomizationApiService()),
 		apiserver.NewBookingAPIController(apiservices.NewBookingAPIService()),
-	)))
+		apiserver.NewHealthAPIController(apiservices.NewHealthApiService()),
+	))))
 
 	err := http.ListenAndServe(":"+common.Getenv("API_SERVER_PORT", "3000"), r)
 	log.Fatal("main", "API server: %v", err)
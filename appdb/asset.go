@@ -30,6 +30,10 @@ type Asset struct {
 	GlobalAssetID   string     `boil:"global_asset_id" json:"global_asset_id" toml:"global_asset_id" yaml:"global_asset_id"`
 	AssetID         null.Int32 `boil:"asset_id" json:"asset_id,omitempty" toml:"asset_id" yaml:"asset_id,omitempty"`
 	Email           string     `boil:"email" json:"email" toml:"email" yaml:"email"`
+	State           string     `boil:"state" json:"state" toml:"state" yaml:"state"`
+	MetadataHash    string     `boil:"metadata_hash" json:"metadata_hash" toml:"metadata_hash" yaml:"metadata_hash"`
+	Mapped          bool       `boil:"mapped" json:"mapped" toml:"mapped" yaml:"mapped"`
+	MissingSince    null.Time  `boil:"missing_since" json:"missing_since,omitempty" toml:"missing_since" yaml:"missing_since,omitempty"`
 
 	R *assetR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L assetL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -42,6 +46,10 @@ var AssetColumns = struct {
 	GlobalAssetID   string
 	AssetID         string
 	Email           string
+	State           string
+	MetadataHash    string
+	Mapped          string
+	MissingSince    string
 }{
 	ID:              "id",
 	ConfigurationID: "configuration_id",
@@ -49,6 +57,10 @@ var AssetColumns = struct {
 	GlobalAssetID:   "global_asset_id",
 	AssetID:         "asset_id",
 	Email:           "email",
+	State:           "state",
+	MetadataHash:    "metadata_hash",
+	Mapped:          "mapped",
+	MissingSince:    "missing_since",
 }
 
 var AssetTableColumns = struct {
@@ -58,6 +70,10 @@ var AssetTableColumns = struct {
 	GlobalAssetID   string
 	AssetID         string
 	Email           string
+	State           string
+	MetadataHash    string
+	Mapped          string
+	MissingSince    string
 }{
 	ID:              "asset.id",
 	ConfigurationID: "asset.configuration_id",
@@ -65,6 +81,10 @@ var AssetTableColumns = struct {
 	GlobalAssetID:   "asset.global_asset_id",
 	AssetID:         "asset.asset_id",
 	Email:           "asset.email",
+	State:           "asset.state",
+	MetadataHash:    "asset.metadata_hash",
+	Mapped:          "asset.mapped",
+	MissingSince:    "asset.missing_since",
 }
 
 // Generated where
@@ -164,6 +184,10 @@ var AssetWhere = struct {
 	GlobalAssetID   whereHelperstring
 	AssetID         whereHelpernull_Int32
 	Email           whereHelperstring
+	State           whereHelperstring
+	MetadataHash    whereHelperstring
+	Mapped          whereHelperbool
+	MissingSince    whereHelpernull_Time
 }{
 	ID:              whereHelperint64{field: "\"microsoft_365\".\"asset\".\"id\""},
 	ConfigurationID: whereHelperint64{field: "\"microsoft_365\".\"asset\".\"configuration_id\""},
@@ -171,6 +195,10 @@ var AssetWhere = struct {
 	GlobalAssetID:   whereHelperstring{field: "\"microsoft_365\".\"asset\".\"global_asset_id\""},
 	AssetID:         whereHelpernull_Int32{field: "\"microsoft_365\".\"asset\".\"asset_id\""},
 	Email:           whereHelperstring{field: "\"microsoft_365\".\"asset\".\"email\""},
+	State:           whereHelperstring{field: "\"microsoft_365\".\"asset\".\"state\""},
+	MetadataHash:    whereHelperstring{field: "\"microsoft_365\".\"asset\".\"metadata_hash\""},
+	Mapped:          whereHelperbool{field: "\"microsoft_365\".\"asset\".\"mapped\""},
+	MissingSince:    whereHelpernull_Time{field: "\"microsoft_365\".\"asset\".\"missing_since\""},
 }
 
 // AssetRels is where relationship names are stored.
@@ -201,9 +229,9 @@ func (r *assetR) GetConfiguration() *Configuration {
 type assetL struct{}
 
 var (
-	assetAllColumns            = []string{"id", "configuration_id", "project_id", "global_asset_id", "asset_id", "email"}
-	assetColumnsWithoutDefault = []string{"project_id", "global_asset_id", "email"}
-	assetColumnsWithDefault    = []string{"id", "configuration_id", "asset_id"}
+	assetAllColumns            = []string{"id", "configuration_id", "project_id", "global_asset_id", "asset_id", "email", "state", "metadata_hash", "mapped", "missing_since"}
+	assetColumnsWithoutDefault = []string{"project_id", "global_asset_id", "email", "missing_since"}
+	assetColumnsWithDefault    = []string{"id", "configuration_id", "asset_id", "state", "metadata_hash", "mapped"}
 	assetPrimaryKeyColumns     = []string{"id"}
 	assetGeneratedColumns      = []string{}
 )
@@ -4,9 +4,21 @@
 package appdb
 
 var TableNames = struct {
-	Asset         string
-	Configuration string
+	Asset             string
+	AssetMapping      string
+	BookingSession    string
+	Configuration     string
+	DeltaState        string
+	DirectoryResource string
+	PresenceLink      string
+	SyncStatus        string
 }{
-	Asset:         "asset",
-	Configuration: "configuration",
+	Asset:             "asset",
+	AssetMapping:      "asset_mapping",
+	BookingSession:    "booking_session",
+	Configuration:     "configuration",
+	DeltaState:        "delta_state",
+	DirectoryResource: "directory_resource",
+	PresenceLink:      "presence_link",
+	SyncStatus:        "sync_status",
 }
@@ -25,87 +25,172 @@ import (
 
 // Configuration is an object representing the database table.
 type Configuration struct {
-	ID              int64             `boil:"id" json:"id" toml:"id" yaml:"id"`
-	ClientID        string            `boil:"client_id" json:"client_id" toml:"client_id" yaml:"client_id"`
-	ClientSecret    string            `boil:"client_secret" json:"client_secret" toml:"client_secret" yaml:"client_secret"`
-	TenantID        string            `boil:"tenant_id" json:"tenant_id" toml:"tenant_id" yaml:"tenant_id"`
-	Username        string            `boil:"username" json:"username" toml:"username" yaml:"username"`
-	Password        string            `boil:"password" json:"password" toml:"password" yaml:"password"`
-	ForEliona       bool              `boil:"for_eliona" json:"for_eliona" toml:"for_eliona" yaml:"for_eliona"`
-	ForProxy        bool              `boil:"for_proxy" json:"for_proxy" toml:"for_proxy" yaml:"for_proxy"`
-	RefreshInterval int32             `boil:"refresh_interval" json:"refresh_interval" toml:"refresh_interval" yaml:"refresh_interval"`
-	RequestTimeout  int32             `boil:"request_timeout" json:"request_timeout" toml:"request_timeout" yaml:"request_timeout"`
-	AssetFilter     null.JSON         `boil:"asset_filter" json:"asset_filter,omitempty" toml:"asset_filter" yaml:"asset_filter,omitempty"`
-	Active          null.Bool         `boil:"active" json:"active,omitempty" toml:"active" yaml:"active,omitempty"`
-	Enable          null.Bool         `boil:"enable" json:"enable,omitempty" toml:"enable" yaml:"enable,omitempty"`
-	ProjectIds      types.StringArray `boil:"project_ids" json:"project_ids,omitempty" toml:"project_ids" yaml:"project_ids,omitempty"`
+	ID                        int64             `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ClientID                  string            `boil:"client_id" json:"client_id" toml:"client_id" yaml:"client_id"`
+	ClientSecret              string            `boil:"client_secret" json:"client_secret" toml:"client_secret" yaml:"client_secret"`
+	TenantID                  string            `boil:"tenant_id" json:"tenant_id" toml:"tenant_id" yaml:"tenant_id"`
+	Username                  string            `boil:"username" json:"username" toml:"username" yaml:"username"`
+	Password                  string            `boil:"password" json:"password" toml:"password" yaml:"password"`
+	ForEliona                 bool              `boil:"for_eliona" json:"for_eliona" toml:"for_eliona" yaml:"for_eliona"`
+	ForProxy                  bool              `boil:"for_proxy" json:"for_proxy" toml:"for_proxy" yaml:"for_proxy"`
+	RefreshInterval           int32             `boil:"refresh_interval" json:"refresh_interval" toml:"refresh_interval" yaml:"refresh_interval"`
+	RequestTimeout            int32             `boil:"request_timeout" json:"request_timeout" toml:"request_timeout" yaml:"request_timeout"`
+	AssetFilter               null.JSON         `boil:"asset_filter" json:"asset_filter,omitempty" toml:"asset_filter" yaml:"asset_filter,omitempty"`
+	Active                    null.Bool         `boil:"active" json:"active,omitempty" toml:"active" yaml:"active,omitempty"`
+	Enable                    null.Bool         `boil:"enable" json:"enable,omitempty" toml:"enable" yaml:"enable,omitempty"`
+	ProjectIds                types.StringArray `boil:"project_ids" json:"project_ids,omitempty" toml:"project_ids" yaml:"project_ids,omitempty"`
+	TimeZone                  string            `boil:"time_zone" json:"time_zone" toml:"time_zone" yaml:"time_zone"`
+	ScheduleWindow            int32             `boil:"schedule_window" json:"schedule_window" toml:"schedule_window" yaml:"schedule_window"`
+	ScheduleInterval          int32             `boil:"schedule_interval" json:"schedule_interval" toml:"schedule_interval" yaml:"schedule_interval"`
+	BatchWorkers              int32             `boil:"batch_workers" json:"batch_workers" toml:"batch_workers" yaml:"batch_workers"`
+	ClientCertificate         string            `boil:"client_certificate" json:"client_certificate" toml:"client_certificate" yaml:"client_certificate"`
+	ClientCertificatePassword string            `boil:"client_certificate_password" json:"client_certificate_password" toml:"client_certificate_password" yaml:"client_certificate_password"`
+	StaleAssetPolicy          string            `boil:"stale_asset_policy" json:"stale_asset_policy" toml:"stale_asset_policy" yaml:"stale_asset_policy"`
+	AssetMode                 string            `boil:"asset_mode" json:"asset_mode" toml:"asset_mode" yaml:"asset_mode"`
+	MappingProperty           string            `boil:"mapping_property" json:"mapping_property" toml:"mapping_property" yaml:"mapping_property"`
+	WorkingHoursStart         string            `boil:"working_hours_start" json:"working_hours_start" toml:"working_hours_start" yaml:"working_hours_start"`
+	WorkingHoursEnd           string            `boil:"working_hours_end" json:"working_hours_end" toml:"working_hours_end" yaml:"working_hours_end"`
+	WorkingDays               types.StringArray `boil:"working_days" json:"working_days,omitempty" toml:"working_days" yaml:"working_days,omitempty"`
+	NoShowTimeout             int32             `boil:"no_show_timeout" json:"no_show_timeout" toml:"no_show_timeout" yaml:"no_show_timeout"`
+	NoShowRelease             bool              `boil:"no_show_release" json:"no_show_release" toml:"no_show_release" yaml:"no_show_release"`
+	BookingMode               string            `boil:"booking_mode" json:"booking_mode" toml:"booking_mode" yaml:"booking_mode"`
+	BookingAccount            string            `boil:"booking_account" json:"booking_account" toml:"booking_account" yaml:"booking_account"`
+	ExtendAssetTypes          bool              `boil:"extend_asset_types" json:"extend_asset_types" toml:"extend_asset_types" yaml:"extend_asset_types"`
 
 	R *configurationR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L configurationL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var ConfigurationColumns = struct {
-	ID              string
-	ClientID        string
-	ClientSecret    string
-	TenantID        string
-	Username        string
-	Password        string
-	ForEliona       string
-	ForProxy        string
-	RefreshInterval string
-	RequestTimeout  string
-	AssetFilter     string
-	Active          string
-	Enable          string
-	ProjectIds      string
+	ID                        string
+	ClientID                  string
+	ClientSecret              string
+	TenantID                  string
+	Username                  string
+	Password                  string
+	ForEliona                 string
+	ForProxy                  string
+	RefreshInterval           string
+	RequestTimeout            string
+	AssetFilter               string
+	Active                    string
+	Enable                    string
+	ProjectIds                string
+	TimeZone                  string
+	ScheduleWindow            string
+	ScheduleInterval          string
+	BatchWorkers              string
+	ClientCertificate         string
+	ClientCertificatePassword string
+	StaleAssetPolicy          string
+	AssetMode                 string
+	MappingProperty           string
+	WorkingHoursStart         string
+	WorkingHoursEnd           string
+	WorkingDays               string
+	NoShowTimeout             string
+	NoShowRelease             string
+	BookingMode               string
+	BookingAccount            string
+	ExtendAssetTypes          string
 }{
-	ID:              "id",
-	ClientID:        "client_id",
-	ClientSecret:    "client_secret",
-	TenantID:        "tenant_id",
-	Username:        "username",
-	Password:        "password",
-	ForEliona:       "for_eliona",
-	ForProxy:        "for_proxy",
-	RefreshInterval: "refresh_interval",
-	RequestTimeout:  "request_timeout",
-	AssetFilter:     "asset_filter",
-	Active:          "active",
-	Enable:          "enable",
-	ProjectIds:      "project_ids",
+	ID:                        "id",
+	ClientID:                  "client_id",
+	ClientSecret:              "client_secret",
+	TenantID:                  "tenant_id",
+	Username:                  "username",
+	Password:                  "password",
+	ForEliona:                 "for_eliona",
+	ForProxy:                  "for_proxy",
+	RefreshInterval:           "refresh_interval",
+	RequestTimeout:            "request_timeout",
+	AssetFilter:               "asset_filter",
+	Active:                    "active",
+	Enable:                    "enable",
+	ProjectIds:                "project_ids",
+	TimeZone:                  "time_zone",
+	ScheduleWindow:            "schedule_window",
+	ScheduleInterval:          "schedule_interval",
+	BatchWorkers:              "batch_workers",
+	ClientCertificate:         "client_certificate",
+	ClientCertificatePassword: "client_certificate_password",
+	StaleAssetPolicy:          "stale_asset_policy",
+	AssetMode:                 "asset_mode",
+	MappingProperty:           "mapping_property",
+	WorkingHoursStart:         "working_hours_start",
+	WorkingHoursEnd:           "working_hours_end",
+	WorkingDays:               "working_days",
+	NoShowTimeout:             "no_show_timeout",
+	NoShowRelease:             "no_show_release",
+	BookingMode:               "booking_mode",
+	BookingAccount:            "booking_account",
+	ExtendAssetTypes:          "extend_asset_types",
 }
 
 var ConfigurationTableColumns = struct {
-	ID              string
-	ClientID        string
-	ClientSecret    string
-	TenantID        string
-	Username        string
-	Password        string
-	ForEliona       string
-	ForProxy        string
-	RefreshInterval string
-	RequestTimeout  string
-	AssetFilter     string
-	Active          string
-	Enable          string
-	ProjectIds      string
+	ID                        string
+	ClientID                  string
+	ClientSecret              string
+	TenantID                  string
+	Username                  string
+	Password                  string
+	ForEliona                 string
+	ForProxy                  string
+	RefreshInterval           string
+	RequestTimeout            string
+	AssetFilter               string
+	Active                    string
+	Enable                    string
+	ProjectIds                string
+	TimeZone                  string
+	ScheduleWindow            string
+	ScheduleInterval          string
+	BatchWorkers              string
+	ClientCertificate         string
+	ClientCertificatePassword string
+	StaleAssetPolicy          string
+	AssetMode                 string
+	MappingProperty           string
+	WorkingHoursStart         string
+	WorkingHoursEnd           string
+	WorkingDays               string
+	NoShowTimeout             string
+	NoShowRelease             string
+	BookingMode               string
+	BookingAccount            string
+	ExtendAssetTypes          string
 }{
-	ID:              "configuration.id",
-	ClientID:        "configuration.client_id",
-	ClientSecret:    "configuration.client_secret",
-	TenantID:        "configuration.tenant_id",
-	Username:        "configuration.username",
-	Password:        "configuration.password",
-	ForEliona:       "configuration.for_eliona",
-	ForProxy:        "configuration.for_proxy",
-	RefreshInterval: "configuration.refresh_interval",
-	RequestTimeout:  "configuration.request_timeout",
-	AssetFilter:     "configuration.asset_filter",
-	Active:          "configuration.active",
-	Enable:          "configuration.enable",
-	ProjectIds:      "configuration.project_ids",
+	ID:                        "configuration.id",
+	ClientID:                  "configuration.client_id",
+	ClientSecret:              "configuration.client_secret",
+	TenantID:                  "configuration.tenant_id",
+	Username:                  "configuration.username",
+	Password:                  "configuration.password",
+	ForEliona:                 "configuration.for_eliona",
+	ForProxy:                  "configuration.for_proxy",
+	RefreshInterval:           "configuration.refresh_interval",
+	RequestTimeout:            "configuration.request_timeout",
+	AssetFilter:               "configuration.asset_filter",
+	Active:                    "configuration.active",
+	Enable:                    "configuration.enable",
+	ProjectIds:                "configuration.project_ids",
+	TimeZone:                  "configuration.time_zone",
+	ScheduleWindow:            "configuration.schedule_window",
+	ScheduleInterval:          "configuration.schedule_interval",
+	BatchWorkers:              "configuration.batch_workers",
+	ClientCertificate:         "configuration.client_certificate",
+	ClientCertificatePassword: "configuration.client_certificate_password",
+	StaleAssetPolicy:          "configuration.stale_asset_policy",
+	AssetMode:                 "configuration.asset_mode",
+	MappingProperty:           "configuration.mapping_property",
+	WorkingHoursStart:         "configuration.working_hours_start",
+	WorkingHoursEnd:           "configuration.working_hours_end",
+	WorkingDays:               "configuration.working_days",
+	NoShowTimeout:             "configuration.no_show_timeout",
+	NoShowRelease:             "configuration.no_show_release",
+	BookingMode:               "configuration.booking_mode",
+	BookingAccount:            "configuration.booking_account",
+	ExtendAssetTypes:          "configuration.extend_asset_types",
 }
 
 // Generated where
@@ -217,47 +302,99 @@ func (w whereHelpertypes_StringArray) IsNotNull() qm.QueryMod {
 }
 
 var ConfigurationWhere = struct {
-	ID              whereHelperint64
-	ClientID        whereHelperstring
-	ClientSecret    whereHelperstring
-	TenantID        whereHelperstring
-	Username        whereHelperstring
-	Password        whereHelperstring
-	ForEliona       whereHelperbool
-	ForProxy        whereHelperbool
-	RefreshInterval whereHelperint32
-	RequestTimeout  whereHelperint32
-	AssetFilter     whereHelpernull_JSON
-	Active          whereHelpernull_Bool
-	Enable          whereHelpernull_Bool
-	ProjectIds      whereHelpertypes_StringArray
+	ID                        whereHelperint64
+	ClientID                  whereHelperstring
+	ClientSecret              whereHelperstring
+	TenantID                  whereHelperstring
+	Username                  whereHelperstring
+	Password                  whereHelperstring
+	ForEliona                 whereHelperbool
+	ForProxy                  whereHelperbool
+	RefreshInterval           whereHelperint32
+	RequestTimeout            whereHelperint32
+	AssetFilter               whereHelpernull_JSON
+	Active                    whereHelpernull_Bool
+	Enable                    whereHelpernull_Bool
+	ProjectIds                whereHelpertypes_StringArray
+	TimeZone                  whereHelperstring
+	ScheduleWindow            whereHelperint32
+	ScheduleInterval          whereHelperint32
+	BatchWorkers              whereHelperint32
+	ClientCertificate         whereHelperstring
+	ClientCertificatePassword whereHelperstring
+	StaleAssetPolicy          whereHelperstring
+	AssetMode                 whereHelperstring
+	MappingProperty           whereHelperstring
+	WorkingHoursStart         whereHelperstring
+	WorkingHoursEnd           whereHelperstring
+	WorkingDays               whereHelpertypes_StringArray
+	NoShowTimeout             whereHelperint32
+	NoShowRelease             whereHelperbool
+	BookingMode               whereHelperstring
+	BookingAccount            whereHelperstring
+	ExtendAssetTypes          whereHelperbool
 }{
-	ID:              whereHelperint64{field: "\"microsoft_365\".\"configuration\".\"id\""},
-	ClientID:        whereHelperstring{field: "\"microsoft_365\".\"configuration\".\"client_id\""},
-	ClientSecret:    whereHelperstring{field: "\"microsoft_365\".\"configuration\".\"client_secret\""},
-	TenantID:        whereHelperstring{field: "\"microsoft_365\".\"configuration\".\"tenant_id\""},
-	Username:        whereHelperstring{field: "\"microsoft_365\".\"configuration\".\"username\""},
-	Password:        whereHelperstring{field: "\"microsoft_365\".\"configuration\".\"password\""},
-	ForEliona:       whereHelperbool{field: "\"microsoft_365\".\"configuration\".\"for_eliona\""},
-	ForProxy:        whereHelperbool{field: "\"microsoft_365\".\"configuration\".\"for_proxy\""},
-	RefreshInterval: whereHelperint32{field: "\"microsoft_365\".\"configuration\".\"refresh_interval\""},
-	RequestTimeout:  whereHelperint32{field: "\"microsoft_365\".\"configuration\".\"request_timeout\""},
-	AssetFilter:     whereHelpernull_JSON{field: "\"microsoft_365\".\"configuration\".\"asset_filter\""},
-	Active:          whereHelpernull_Bool{field: "\"microsoft_365\".\"configuration\".\"active\""},
-	Enable:          whereHelpernull_Bool{field: "\"microsoft_365\".\"configuration\".\"enable\""},
-	ProjectIds:      whereHelpertypes_StringArray{field: "\"microsoft_365\".\"configuration\".\"project_ids\""},
+	ID:                        whereHelperint64{field: "\"microsoft_365\".\"configuration\".\"id\""},
+	ClientID:                  whereHelperstring{field: "\"microsoft_365\".\"configuration\".\"client_id\""},
+	ClientSecret:              whereHelperstring{field: "\"microsoft_365\".\"configuration\".\"client_secret\""},
+	TenantID:                  whereHelperstring{field: "\"microsoft_365\".\"configuration\".\"tenant_id\""},
+	Username:                  whereHelperstring{field: "\"microsoft_365\".\"configuration\".\"username\""},
+	Password:                  whereHelperstring{field: "\"microsoft_365\".\"configuration\".\"password\""},
+	ForEliona:                 whereHelperbool{field: "\"microsoft_365\".\"configuration\".\"for_eliona\""},
+	ForProxy:                  whereHelperbool{field: "\"microsoft_365\".\"configuration\".\"for_proxy\""},
+	RefreshInterval:           whereHelperint32{field: "\"microsoft_365\".\"configuration\".\"refresh_interval\""},
+	RequestTimeout:            whereHelperint32{field: "\"microsoft_365\".\"configuration\".\"request_timeout\""},
+	AssetFilter:               whereHelpernull_JSON{field: "\"microsoft_365\".\"configuration\".\"asset_filter\""},
+	Active:                    whereHelpernull_Bool{field: "\"microsoft_365\".\"configuration\".\"active\""},
+	Enable:                    whereHelpernull_Bool{field: "\"microsoft_365\".\"configuration\".\"enable\""},
+	ProjectIds:                whereHelpertypes_StringArray{field: "\"microsoft_365\".\"configuration\".\"project_ids\""},
+	TimeZone:                  whereHelperstring{field: "\"microsoft_365\".\"configuration\".\"time_zone\""},
+	ScheduleWindow:            whereHelperint32{field: "\"microsoft_365\".\"configuration\".\"schedule_window\""},
+	ScheduleInterval:          whereHelperint32{field: "\"microsoft_365\".\"configuration\".\"schedule_interval\""},
+	BatchWorkers:              whereHelperint32{field: "\"microsoft_365\".\"configuration\".\"batch_workers\""},
+	ClientCertificate:         whereHelperstring{field: "\"microsoft_365\".\"configuration\".\"client_certificate\""},
+	ClientCertificatePassword: whereHelperstring{field: "\"microsoft_365\".\"configuration\".\"client_certificate_password\""},
+	StaleAssetPolicy:          whereHelperstring{field: "\"microsoft_365\".\"configuration\".\"stale_asset_policy\""},
+	AssetMode:                 whereHelperstring{field: "\"microsoft_365\".\"configuration\".\"asset_mode\""},
+	MappingProperty:           whereHelperstring{field: "\"microsoft_365\".\"configuration\".\"mapping_property\""},
+	WorkingHoursStart:         whereHelperstring{field: "\"microsoft_365\".\"configuration\".\"working_hours_start\""},
+	WorkingHoursEnd:           whereHelperstring{field: "\"microsoft_365\".\"configuration\".\"working_hours_end\""},
+	WorkingDays:               whereHelpertypes_StringArray{field: "\"microsoft_365\".\"configuration\".\"working_days\""},
+	NoShowTimeout:             whereHelperint32{field: "\"microsoft_365\".\"configuration\".\"no_show_timeout\""},
+	NoShowRelease:             whereHelperbool{field: "\"microsoft_365\".\"configuration\".\"no_show_release\""},
+	BookingMode:               whereHelperstring{field: "\"microsoft_365\".\"configuration\".\"booking_mode\""},
+	BookingAccount:            whereHelperstring{field: "\"microsoft_365\".\"configuration\".\"booking_account\""},
+	ExtendAssetTypes:          whereHelperbool{field: "\"microsoft_365\".\"configuration\".\"extend_asset_types\""},
 }
 
 // ConfigurationRels is where relationship names are stored.
 var ConfigurationRels = struct {
-	Assets string
+	SyncStatus         string
+	Assets             string
+	DeltaStates        string
+	DirectoryResources string
+	AssetMappings      string
+	PresenceLinks      string
+	BookingSessions    string
 }{
-	Assets: "Assets",
+	SyncStatus:         "SyncStatus",
+	Assets:             "Assets",
+	DeltaStates:        "DeltaStates",
+	DirectoryResources: "DirectoryResources",
+	AssetMappings:      "AssetMappings",
+	PresenceLinks:      "PresenceLinks",
+	BookingSessions:    "BookingSessions",
 }
 
 // configurationR is where relationships are stored.
 type configurationR struct {
-	Assets AssetSlice `boil:"Assets" json:"Assets" toml:"Assets" yaml:"Assets"`
+	SyncStatus         *SyncStatus            `boil:"SyncStatus" json:"SyncStatus" toml:"SyncStatus" yaml:"SyncStatus"`
+	Assets             AssetSlice             `boil:"Assets" json:"Assets" toml:"Assets" yaml:"Assets"`
+	DeltaStates        DeltaStateSlice        `boil:"DeltaStates" json:"DeltaStates" toml:"DeltaStates" yaml:"DeltaStates"`
+	DirectoryResources DirectoryResourceSlice `boil:"DirectoryResources" json:"DirectoryResources" toml:"DirectoryResources" yaml:"DirectoryResources"`
+	AssetMappings      AssetMappingSlice      `boil:"AssetMappings" json:"AssetMappings" toml:"AssetMappings" yaml:"AssetMappings"`
+	PresenceLinks      PresenceLinkSlice      `boil:"PresenceLinks" json:"PresenceLinks" toml:"PresenceLinks" yaml:"PresenceLinks"`
+	BookingSessions    BookingSessionSlice    `boil:"BookingSessions" json:"BookingSessions" toml:"BookingSessions" yaml:"BookingSessions"`
 }
 
 // NewStruct creates a new relationship struct
@@ -265,6 +402,13 @@ func (*configurationR) NewStruct() *configurationR {
 	return &configurationR{}
 }
 
+func (r *configurationR) GetSyncStatus() *SyncStatus {
+	if r == nil {
+		return nil
+	}
+	return r.SyncStatus
+}
+
 func (r *configurationR) GetAssets() AssetSlice {
 	if r == nil {
 		return nil
@@ -272,13 +416,48 @@ func (r *configurationR) GetAssets() AssetSlice {
 	return r.Assets
 }
 
+func (r *configurationR) GetBookingSessions() BookingSessionSlice {
+	if r == nil {
+		return nil
+	}
+	return r.BookingSessions
+}
+
+func (r *configurationR) GetPresenceLinks() PresenceLinkSlice {
+	if r == nil {
+		return nil
+	}
+	return r.PresenceLinks
+}
+
+func (r *configurationR) GetAssetMappings() AssetMappingSlice {
+	if r == nil {
+		return nil
+	}
+	return r.AssetMappings
+}
+
+func (r *configurationR) GetDirectoryResources() DirectoryResourceSlice {
+	if r == nil {
+		return nil
+	}
+	return r.DirectoryResources
+}
+
+func (r *configurationR) GetDeltaStates() DeltaStateSlice {
+	if r == nil {
+		return nil
+	}
+	return r.DeltaStates
+}
+
 // configurationL is where Load methods for each relationship are stored.
 type configurationL struct{}
 
 var (
-	configurationAllColumns            = []string{"id", "client_id", "client_secret", "tenant_id", "username", "password", "for_eliona", "for_proxy", "refresh_interval", "request_timeout", "asset_filter", "active", "enable", "project_ids"}
+	configurationAllColumns            = []string{"id", "client_id", "client_secret", "tenant_id", "username", "password", "for_eliona", "for_proxy", "refresh_interval", "request_timeout", "asset_filter", "active", "enable", "project_ids", "time_zone", "schedule_window", "schedule_interval", "batch_workers", "client_certificate", "client_certificate_password", "stale_asset_policy", "asset_mode", "mapping_property", "working_hours_start", "working_hours_end", "working_days", "no_show_timeout", "no_show_release", "booking_mode", "booking_account", "extend_asset_types"}
 	configurationColumnsWithoutDefault = []string{"client_id", "client_secret", "tenant_id", "username", "password"}
-	configurationColumnsWithDefault    = []string{"id", "for_eliona", "for_proxy", "refresh_interval", "request_timeout", "asset_filter", "active", "enable", "project_ids"}
+	configurationColumnsWithDefault    = []string{"id", "for_eliona", "for_proxy", "refresh_interval", "request_timeout", "asset_filter", "active", "enable", "project_ids", "time_zone", "schedule_window", "schedule_interval", "batch_workers", "client_certificate", "client_certificate_password", "stale_asset_policy", "asset_mode", "mapping_property", "working_hours_start", "working_hours_end", "working_days", "no_show_timeout", "no_show_release", "booking_mode", "booking_account", "extend_asset_types"}
 	configurationPrimaryKeyColumns     = []string{"id"}
 	configurationGeneratedColumns      = []string{}
 )
@@ -581,6 +760,192 @@ func (q configurationQuery) Exists(ctx context.Context, exec boil.ContextExecuto
 	return count > 0, nil
 }
 
+// SyncStatus pointed to by the foreign key.
+func (o *Configuration) SyncStatus(mods ...qm.QueryMod) syncStatusQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"configuration_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return SyncStatuses(queryMods...)
+}
+
+// LoadSyncStatus allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (configurationL) LoadSyncStatus(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
+	var slice []*Configuration
+	var object *Configuration
+
+	if singular {
+		var ok bool
+		object, ok = maybeConfiguration.(*Configuration)
+		if !ok {
+			object = new(Configuration)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeConfiguration))
+			}
+		}
+	} else {
+		s, ok := maybeConfiguration.(*[]*Configuration)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeConfiguration))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &configurationR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &configurationR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`microsoft_365.sync_status`),
+		qm.WhereIn(`microsoft_365.sync_status.configuration_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load SyncStatus")
+	}
+
+	var resultSlice []*SyncStatus
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice SyncStatus")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for sync_status")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for sync_status")
+	}
+
+	if len(syncStatusAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.SyncStatus = foreign
+		if foreign.R == nil {
+			foreign.R = &syncStatusR{}
+		}
+		foreign.R.Configuration = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.ConfigurationID {
+				local.R.SyncStatus = foreign
+				if foreign.R == nil {
+					foreign.R = &syncStatusR{}
+				}
+				foreign.R.Configuration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetSyncStatusG of the configuration to the related item.
+// Sets o.R.SyncStatus to related.
+// Adds o to related.R.Configuration.
+// Uses the global database handle.
+func (o *Configuration) SetSyncStatusG(ctx context.Context, insert bool, related *SyncStatus) error {
+	return o.SetSyncStatus(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetSyncStatus of the configuration to the related item.
+// Sets o.R.SyncStatus to related.
+// Adds o to related.R.Configuration.
+func (o *Configuration) SetSyncStatus(ctx context.Context, exec boil.ContextExecutor, insert bool, related *SyncStatus) error {
+	var err error
+
+	if insert {
+		related.ConfigurationID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"microsoft_365\".\"sync_status\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+			strmangle.WhereClause("\"", "\"", 2, syncStatusPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.ConfigurationID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &configurationR{
+			SyncStatus: related,
+		}
+	} else {
+		o.R.SyncStatus = related
+	}
+
+	if related.R == nil {
+		related.R = &syncStatusR{
+			Configuration: o,
+		}
+	} else {
+		related.R.Configuration = o
+	}
+	return nil
+}
+
 // Assets retrieves all the asset's Assets with an executor.
 func (o *Configuration) Assets(mods ...qm.QueryMod) assetQuery {
 	var queryMods []qm.QueryMod
@@ -771,6 +1136,956 @@ func (o *Configuration) AddAssets(ctx context.Context, exec boil.ContextExecutor
 	return nil
 }
 
+// BookingSessions retrieves all the booking_session's BookingSessions with an executor.
+func (o *Configuration) BookingSessions(mods ...qm.QueryMod) bookingSessionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"microsoft_365\".\"booking_session\".\"configuration_id\"=?", o.ID),
+	)
+
+	return BookingSessions(queryMods...)
+}
+
+// LoadBookingSessions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (configurationL) LoadBookingSessions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
+	var slice []*Configuration
+	var object *Configuration
+
+	if singular {
+		var ok bool
+		object, ok = maybeConfiguration.(*Configuration)
+		if !ok {
+			object = new(Configuration)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeConfiguration))
+			}
+		}
+	} else {
+		s, ok := maybeConfiguration.(*[]*Configuration)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeConfiguration))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &configurationR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &configurationR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`microsoft_365.booking_session`),
+		qm.WhereIn(`microsoft_365.booking_session.configuration_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load booking_session")
+	}
+
+	var resultSlice []*BookingSession
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice booking_session")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on booking_session")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for booking_session")
+	}
+
+	if len(bookingSessionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.BookingSessions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &bookingSessionR{}
+			}
+			foreign.R.Configuration = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ConfigurationID {
+				local.R.BookingSessions = append(local.R.BookingSessions, foreign)
+				if foreign.R == nil {
+					foreign.R = &bookingSessionR{}
+				}
+				foreign.R.Configuration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddBookingSessionsG adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.BookingSessions.
+// Sets related.R.Configuration appropriately.
+// Uses the global database handle.
+func (o *Configuration) AddBookingSessionsG(ctx context.Context, insert bool, related ...*BookingSession) error {
+	return o.AddBookingSessions(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddBookingSessions adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.BookingSessions.
+// Sets related.R.Configuration appropriately.
+func (o *Configuration) AddBookingSessions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BookingSession) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ConfigurationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"microsoft_365\".\"booking_session\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+				strmangle.WhereClause("\"", "\"", 2, bookingSessionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ConfigurationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &configurationR{
+			BookingSessions: related,
+		}
+	} else {
+		o.R.BookingSessions = append(o.R.BookingSessions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &bookingSessionR{
+				Configuration: o,
+			}
+		} else {
+			rel.R.Configuration = o
+		}
+	}
+	return nil
+}
+
+// PresenceLinks retrieves all the presence_link's PresenceLinks with an executor.
+func (o *Configuration) PresenceLinks(mods ...qm.QueryMod) presenceLinkQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"microsoft_365\".\"presence_link\".\"configuration_id\"=?", o.ID),
+	)
+
+	return PresenceLinks(queryMods...)
+}
+
+// LoadPresenceLinks allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (configurationL) LoadPresenceLinks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
+	var slice []*Configuration
+	var object *Configuration
+
+	if singular {
+		var ok bool
+		object, ok = maybeConfiguration.(*Configuration)
+		if !ok {
+			object = new(Configuration)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeConfiguration))
+			}
+		}
+	} else {
+		s, ok := maybeConfiguration.(*[]*Configuration)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeConfiguration))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &configurationR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &configurationR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`microsoft_365.presence_link`),
+		qm.WhereIn(`microsoft_365.presence_link.configuration_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load presence_link")
+	}
+
+	var resultSlice []*PresenceLink
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice presence_link")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on presence_link")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for presence_link")
+	}
+
+	if len(presenceLinkAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PresenceLinks = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &presenceLinkR{}
+			}
+			foreign.R.Configuration = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ConfigurationID {
+				local.R.PresenceLinks = append(local.R.PresenceLinks, foreign)
+				if foreign.R == nil {
+					foreign.R = &presenceLinkR{}
+				}
+				foreign.R.Configuration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddPresenceLinksG adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.PresenceLinks.
+// Sets related.R.Configuration appropriately.
+// Uses the global database handle.
+func (o *Configuration) AddPresenceLinksG(ctx context.Context, insert bool, related ...*PresenceLink) error {
+	return o.AddPresenceLinks(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddPresenceLinks adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.PresenceLinks.
+// Sets related.R.Configuration appropriately.
+func (o *Configuration) AddPresenceLinks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PresenceLink) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ConfigurationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"microsoft_365\".\"presence_link\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+				strmangle.WhereClause("\"", "\"", 2, presenceLinkPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ConfigurationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &configurationR{
+			PresenceLinks: related,
+		}
+	} else {
+		o.R.PresenceLinks = append(o.R.PresenceLinks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &presenceLinkR{
+				Configuration: o,
+			}
+		} else {
+			rel.R.Configuration = o
+		}
+	}
+	return nil
+}
+
+// AssetMappings retrieves all the asset_mapping's AssetMappings with an executor.
+func (o *Configuration) AssetMappings(mods ...qm.QueryMod) assetMappingQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"microsoft_365\".\"asset_mapping\".\"configuration_id\"=?", o.ID),
+	)
+
+	return AssetMappings(queryMods...)
+}
+
+// LoadAssetMappings allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (configurationL) LoadAssetMappings(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
+	var slice []*Configuration
+	var object *Configuration
+
+	if singular {
+		var ok bool
+		object, ok = maybeConfiguration.(*Configuration)
+		if !ok {
+			object = new(Configuration)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeConfiguration))
+			}
+		}
+	} else {
+		s, ok := maybeConfiguration.(*[]*Configuration)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeConfiguration))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &configurationR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &configurationR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`microsoft_365.asset_mapping`),
+		qm.WhereIn(`microsoft_365.asset_mapping.configuration_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load asset_mapping")
+	}
+
+	var resultSlice []*AssetMapping
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice asset_mapping")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on asset_mapping")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset_mapping")
+	}
+
+	if len(assetMappingAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.AssetMappings = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &assetMappingR{}
+			}
+			foreign.R.Configuration = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ConfigurationID {
+				local.R.AssetMappings = append(local.R.AssetMappings, foreign)
+				if foreign.R == nil {
+					foreign.R = &assetMappingR{}
+				}
+				foreign.R.Configuration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddAssetMappingsG adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.AssetMappings.
+// Sets related.R.Configuration appropriately.
+// Uses the global database handle.
+func (o *Configuration) AddAssetMappingsG(ctx context.Context, insert bool, related ...*AssetMapping) error {
+	return o.AddAssetMappings(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddAssetMappings adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.AssetMappings.
+// Sets related.R.Configuration appropriately.
+func (o *Configuration) AddAssetMappings(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AssetMapping) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ConfigurationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"microsoft_365\".\"asset_mapping\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+				strmangle.WhereClause("\"", "\"", 2, assetMappingPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ConfigurationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &configurationR{
+			AssetMappings: related,
+		}
+	} else {
+		o.R.AssetMappings = append(o.R.AssetMappings, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &assetMappingR{
+				Configuration: o,
+			}
+		} else {
+			rel.R.Configuration = o
+		}
+	}
+	return nil
+}
+
+// DirectoryResources retrieves all the directory_resource's DirectoryResources with an executor.
+func (o *Configuration) DirectoryResources(mods ...qm.QueryMod) directoryResourceQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"microsoft_365\".\"directory_resource\".\"configuration_id\"=?", o.ID),
+	)
+
+	return DirectoryResources(queryMods...)
+}
+
+// LoadDirectoryResources allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (configurationL) LoadDirectoryResources(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
+	var slice []*Configuration
+	var object *Configuration
+
+	if singular {
+		var ok bool
+		object, ok = maybeConfiguration.(*Configuration)
+		if !ok {
+			object = new(Configuration)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeConfiguration))
+			}
+		}
+	} else {
+		s, ok := maybeConfiguration.(*[]*Configuration)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeConfiguration))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &configurationR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &configurationR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`microsoft_365.directory_resource`),
+		qm.WhereIn(`microsoft_365.directory_resource.configuration_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load directory_resource")
+	}
+
+	var resultSlice []*DirectoryResource
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice directory_resource")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on directory_resource")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for directory_resource")
+	}
+
+	if len(directoryResourceAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.DirectoryResources = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &directoryResourceR{}
+			}
+			foreign.R.Configuration = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ConfigurationID {
+				local.R.DirectoryResources = append(local.R.DirectoryResources, foreign)
+				if foreign.R == nil {
+					foreign.R = &directoryResourceR{}
+				}
+				foreign.R.Configuration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddDirectoryResourcesG adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.DirectoryResources.
+// Sets related.R.Configuration appropriately.
+// Uses the global database handle.
+func (o *Configuration) AddDirectoryResourcesG(ctx context.Context, insert bool, related ...*DirectoryResource) error {
+	return o.AddDirectoryResources(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddDirectoryResources adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.DirectoryResources.
+// Sets related.R.Configuration appropriately.
+func (o *Configuration) AddDirectoryResources(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DirectoryResource) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ConfigurationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"microsoft_365\".\"directory_resource\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+				strmangle.WhereClause("\"", "\"", 2, directoryResourcePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ConfigurationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &configurationR{
+			DirectoryResources: related,
+		}
+	} else {
+		o.R.DirectoryResources = append(o.R.DirectoryResources, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &directoryResourceR{
+				Configuration: o,
+			}
+		} else {
+			rel.R.Configuration = o
+		}
+	}
+	return nil
+}
+
+// DeltaStates retrieves all the delta_state's DeltaStates with an executor.
+func (o *Configuration) DeltaStates(mods ...qm.QueryMod) deltaStateQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"microsoft_365\".\"delta_state\".\"configuration_id\"=?", o.ID),
+	)
+
+	return DeltaStates(queryMods...)
+}
+
+// LoadDeltaStates allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (configurationL) LoadDeltaStates(ctx context.Context, e boil.ContextExecutor, singular bool, maybeConfiguration interface{}, mods queries.Applicator) error {
+	var slice []*Configuration
+	var object *Configuration
+
+	if singular {
+		var ok bool
+		object, ok = maybeConfiguration.(*Configuration)
+		if !ok {
+			object = new(Configuration)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeConfiguration))
+			}
+		}
+	} else {
+		s, ok := maybeConfiguration.(*[]*Configuration)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeConfiguration)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeConfiguration))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &configurationR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &configurationR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`microsoft_365.delta_state`),
+		qm.WhereIn(`microsoft_365.delta_state.configuration_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load delta_state")
+	}
+
+	var resultSlice []*DeltaState
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice delta_state")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on delta_state")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for delta_state")
+	}
+
+	if len(deltaStateAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.DeltaStates = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &deltaStateR{}
+			}
+			foreign.R.Configuration = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ConfigurationID {
+				local.R.DeltaStates = append(local.R.DeltaStates, foreign)
+				if foreign.R == nil {
+					foreign.R = &deltaStateR{}
+				}
+				foreign.R.Configuration = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddDeltaStatesG adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.DeltaStates.
+// Sets related.R.Configuration appropriately.
+// Uses the global database handle.
+func (o *Configuration) AddDeltaStatesG(ctx context.Context, insert bool, related ...*DeltaState) error {
+	return o.AddDeltaStates(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddDeltaStates adds the given related objects to the existing relationships
+// of the configuration, optionally inserting them as new records.
+// Appends related to o.R.DeltaStates.
+// Sets related.R.Configuration appropriately.
+func (o *Configuration) AddDeltaStates(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DeltaState) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ConfigurationID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"microsoft_365\".\"delta_state\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"configuration_id"}),
+				strmangle.WhereClause("\"", "\"", 2, deltaStatePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ConfigurationID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &configurationR{
+			DeltaStates: related,
+		}
+	} else {
+		o.R.DeltaStates = append(o.R.DeltaStates, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &deltaStateR{
+				Configuration: o,
+			}
+		} else {
+			rel.R.Configuration = o
+		}
+	}
+	return nil
+}
+
 // Configurations retrieves all the records using an executor.
 func Configurations(mods ...qm.QueryMod) configurationQuery {
 	mods = append(mods, qm.From("\"microsoft_365\".\"configuration\""))
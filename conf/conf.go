@@ -22,11 +22,15 @@ import (
 	"fmt"
 	"microsoft-365/apiserver"
 	"microsoft-365/appdb"
+	"strings"
+	"time"
 
 	"github.com/eliona-smart-building-assistant/go-utils/common"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/types"
 	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
 var ErrBadRequest = errors.New("bad request")
@@ -97,6 +101,12 @@ func dbConfigFromApiConfig(apiConfig apiserver.Configuration) (dbConfig appdb.Co
 	if apiConfig.ClientSecret != nil {
 		dbConfig.ClientSecret = *apiConfig.ClientSecret
 	}
+	if apiConfig.ClientCertificate != nil {
+		dbConfig.ClientCertificate = *apiConfig.ClientCertificate
+	}
+	if apiConfig.ClientCertificatePassword != nil {
+		dbConfig.ClientCertificatePassword = *apiConfig.ClientCertificatePassword
+	}
 	dbConfig.TenantID = apiConfig.TenantId
 	if apiConfig.Username != nil {
 		dbConfig.Username = *apiConfig.Username
@@ -120,14 +130,48 @@ func dbConfigFromApiConfig(apiConfig apiserver.Configuration) (dbConfig appdb.Co
 	if apiConfig.ProjectIDs != nil {
 		dbConfig.ProjectIds = *apiConfig.ProjectIDs
 	}
+	dbConfig.TimeZone = apiConfig.TimeZone
+	dbConfig.ScheduleWindow = apiConfig.ScheduleWindow
+	dbConfig.ScheduleInterval = apiConfig.ScheduleInterval
+	dbConfig.BatchWorkers = apiConfig.BatchWorkers
+	dbConfig.StaleAssetPolicy = apiConfig.StaleAssetPolicy
+	dbConfig.AssetMode = apiConfig.AssetMode
+	if apiConfig.MappingProperty != nil {
+		dbConfig.MappingProperty = *apiConfig.MappingProperty
+	}
+	dbConfig.ExtendAssetTypes = apiConfig.ExtendAssetTypes != nil && *apiConfig.ExtendAssetTypes
+	dbConfig.WorkingHoursStart = apiConfig.WorkingHoursStart
+	dbConfig.WorkingHoursEnd = apiConfig.WorkingHoursEnd
+	dbConfig.WorkingDays = apiConfig.WorkingDays
+	dbConfig.NoShowTimeout = apiConfig.NoShowTimeout
+	dbConfig.NoShowRelease = apiConfig.NoShowRelease != nil && *apiConfig.NoShowRelease
+	dbConfig.BookingMode = apiConfig.BookingMode
+	if apiConfig.BookingAccount != nil {
+		dbConfig.BookingAccount = *apiConfig.BookingAccount
+	}
+	for _, secret := range secretFields(&dbConfig) {
+		if *secret, err = encryptSecret(*secret); err != nil {
+			return appdb.Configuration{}, fmt.Errorf("encrypting secret: %v", err)
+		}
+	}
 
 	return dbConfig, nil
 }
 
 func apiConfigFromDbConfig(dbConfig *appdb.Configuration) (apiConfig apiserver.Configuration, err error) {
+	// Decrypt a copy, so that the secrets stay encrypted in the record.
+	decrypted := *dbConfig
+	for _, secret := range secretFields(&decrypted) {
+		if *secret, err = decryptSecret(*secret); err != nil {
+			return apiserver.Configuration{}, fmt.Errorf("decrypting secret: %v", err)
+		}
+	}
+	dbConfig = &decrypted
 	apiConfig.Id = &dbConfig.ID
 	apiConfig.ClientId = dbConfig.ClientID
 	apiConfig.ClientSecret = &dbConfig.ClientSecret
+	apiConfig.ClientCertificate = &dbConfig.ClientCertificate
+	apiConfig.ClientCertificatePassword = &dbConfig.ClientCertificatePassword
 	apiConfig.TenantId = dbConfig.TenantID
 	apiConfig.Username = &dbConfig.Username
 	apiConfig.Password = &dbConfig.Password
@@ -145,6 +189,21 @@ func apiConfigFromDbConfig(dbConfig *appdb.Configuration) (apiConfig apiserver.C
 	}
 	apiConfig.Active = dbConfig.Active.Ptr()
 	apiConfig.ProjectIDs = common.Ptr[[]string](dbConfig.ProjectIds)
+	apiConfig.TimeZone = dbConfig.TimeZone
+	apiConfig.ScheduleWindow = dbConfig.ScheduleWindow
+	apiConfig.ScheduleInterval = dbConfig.ScheduleInterval
+	apiConfig.BatchWorkers = dbConfig.BatchWorkers
+	apiConfig.StaleAssetPolicy = dbConfig.StaleAssetPolicy
+	apiConfig.AssetMode = dbConfig.AssetMode
+	apiConfig.MappingProperty = &dbConfig.MappingProperty
+	apiConfig.ExtendAssetTypes = &dbConfig.ExtendAssetTypes
+	apiConfig.WorkingHoursStart = dbConfig.WorkingHoursStart
+	apiConfig.WorkingHoursEnd = dbConfig.WorkingHoursEnd
+	apiConfig.WorkingDays = dbConfig.WorkingDays
+	apiConfig.NoShowTimeout = dbConfig.NoShowTimeout
+	apiConfig.NoShowRelease = &dbConfig.NoShowRelease
+	apiConfig.BookingMode = dbConfig.BookingMode
+	apiConfig.BookingAccount = &dbConfig.BookingAccount
 	return apiConfig, nil
 }
 
@@ -255,17 +314,119 @@ func SetAllConfigsInactive(ctx context.Context) (int64, error) {
 	})
 }
 
-func InsertAsset(ctx context.Context, config apiserver.Configuration, projId string, globalAssetID string, assetId int32, email string) error {
+func InsertAsset(ctx context.Context, config apiserver.Configuration, projId string, globalAssetID string, assetId int32, email string, metadataHash string) error {
 	dbAsset := appdb.Asset{
 		ConfigurationID: null.Int64FromPtr(config.Id).Int64,
 		ProjectID:       projId,
 		GlobalAssetID:   globalAssetID,
 		AssetID:         null.Int32From(assetId),
 		Email:           email,
+		MetadataHash:    metadataHash,
 	}
 	return dbAsset.InsertG(ctx, boil.Infer())
 }
 
+// States of the assets in the database. Assets of resources removed from Microsoft 365 are
+// inactive or archived, depending on the stale asset policy of the configuration.
+const (
+	AssetStateActive   = "active"
+	AssetStateInactive = "inactive"
+	AssetStateArchived = "archived"
+)
+
+// FindAsset returns the asset with the global asset ID, or nil if there is none.
+func FindAsset(ctx context.Context, config apiserver.Configuration, projId string, globalAssetID string) (*appdb.Asset, error) {
+	dbAssets, err := appdb.Assets(
+		appdb.AssetWhere.ConfigurationID.EQ(null.Int64FromPtr(config.Id).Int64),
+		appdb.AssetWhere.ProjectID.EQ(projId),
+		appdb.AssetWhere.GlobalAssetID.EQ(globalAssetID),
+	).AllG(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching asset from database: %v", err)
+	}
+	if len(dbAssets) == 0 {
+		return nil, nil
+	}
+	return dbAssets[0], nil
+}
+
+func GetAssets(ctx context.Context, config apiserver.Configuration) (appdb.AssetSlice, error) {
+	dbAssets, err := appdb.Assets(
+		appdb.AssetWhere.ConfigurationID.EQ(null.Int64FromPtr(config.Id).Int64),
+	).AllG(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching assets from database: %v", err)
+	}
+	return dbAssets, nil
+}
+
+func SetAssetState(ctx context.Context, dbAsset *appdb.Asset, state string) error {
+	dbAsset.State = state
+	if _, err := dbAsset.UpdateG(ctx, boil.Whitelist(appdb.AssetColumns.State)); err != nil {
+		return fmt.Errorf("updating asset state: %v", err)
+	}
+	return nil
+}
+
+// SetAssetMissingSince stores since when the resource of the asset is missing in Microsoft 365.
+// A null time marks the resource as present.
+func SetAssetMissingSince(ctx context.Context, dbAsset *appdb.Asset, missingSince null.Time) error {
+	dbAsset.MissingSince = missingSince
+	if _, err := dbAsset.UpdateG(ctx, boil.Whitelist(appdb.AssetColumns.MissingSince)); err != nil {
+		return fmt.Errorf("updating asset missing since: %v", err)
+	}
+	return nil
+}
+
+// SetAssetMetadataHash stores the hash of the name and description last written to Eliona.
+func SetAssetMetadataHash(ctx context.Context, dbAsset *appdb.Asset, metadataHash string) error {
+	dbAsset.MetadataHash = metadataHash
+	if _, err := dbAsset.UpdateG(ctx, boil.Whitelist(appdb.AssetColumns.MetadataHash)); err != nil {
+		return fmt.Errorf("updating asset metadata hash: %v", err)
+	}
+	return nil
+}
+
+// UpsertMappedAsset links the resource to an existing Eliona asset. The app never changes or
+// deletes mapped assets in Eliona.
+func UpsertMappedAsset(ctx context.Context, config apiserver.Configuration, projId string, globalAssetID string, assetId int32, email string) error {
+	dbAsset, err := FindAsset(ctx, config, projId, globalAssetID)
+	if err != nil {
+		return err
+	}
+	if dbAsset == nil {
+		dbAsset := appdb.Asset{
+			ConfigurationID: null.Int64FromPtr(config.Id).Int64,
+			ProjectID:       projId,
+			GlobalAssetID:   globalAssetID,
+			AssetID:         null.Int32From(assetId),
+			Email:           email,
+			Mapped:          true,
+		}
+		if err := dbAsset.InsertG(ctx, boil.Infer()); err != nil {
+			return fmt.Errorf("inserting mapped asset: %v", err)
+		}
+		return nil
+	}
+	if dbAsset.Mapped && dbAsset.AssetID.Int32 == assetId && dbAsset.State == AssetStateActive {
+		return nil
+	}
+	dbAsset.AssetID = null.Int32From(assetId)
+	dbAsset.Mapped = true
+	dbAsset.State = AssetStateActive
+	if _, err := dbAsset.UpdateG(ctx, boil.Whitelist(appdb.AssetColumns.AssetID, appdb.AssetColumns.Mapped, appdb.AssetColumns.State)); err != nil {
+		return fmt.Errorf("updating mapped asset: %v", err)
+	}
+	return nil
+}
+
+func DeleteAsset(ctx context.Context, dbAsset *appdb.Asset) error {
+	if _, err := dbAsset.DeleteG(ctx); err != nil {
+		return fmt.Errorf("deleting asset from database: %v", err)
+	}
+	return nil
+}
+
 func GetAssetId(ctx context.Context, config apiserver.Configuration, projId string, globalAssetID string) (*int32, error) {
 	dbAsset, err := appdb.Assets(
 		appdb.AssetWhere.ConfigurationID.EQ(null.Int64FromPtr(config.Id).Int64),
@@ -283,3 +444,347 @@ func GetAsset(ctx context.Context, assetId int32) (*appdb.Asset, error) {
 		appdb.AssetWhere.AssetID.EQ(null.Int32From(assetId)),
 	).OneG(ctx)
 }
+
+// GetDeltaState returns the state of the delta query for the resource, or nil if there is none yet.
+func GetDeltaState(ctx context.Context, config apiserver.Configuration, resource string) (*appdb.DeltaState, error) {
+	states, err := appdb.DeltaStates(
+		appdb.DeltaStateWhere.ConfigurationID.EQ(null.Int64FromPtr(config.Id).Int64),
+		appdb.DeltaStateWhere.Resource.EQ(resource),
+	).AllG(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching delta state from database: %v", err)
+	}
+	if len(states) == 0 {
+		return nil, nil
+	}
+	return states[0], nil
+}
+
+func UpsertDeltaState(ctx context.Context, config apiserver.Configuration, state appdb.DeltaState) error {
+	state.ConfigurationID = null.Int64FromPtr(config.Id).Int64
+	if state.FailedUserIds == nil {
+		state.FailedUserIds = types.StringArray{}
+	}
+	if err := state.UpsertG(ctx, true,
+		[]string{appdb.DeltaStateColumns.ConfigurationID, appdb.DeltaStateColumns.Resource},
+		boil.Whitelist(appdb.DeltaStateColumns.DeltaLink, appdb.DeltaStateColumns.RoomsRefreshedAt, appdb.DeltaStateColumns.FailedUserIds),
+		boil.Greylist(appdb.DeltaStateColumns.RoomsRefreshedAt),
+	); err != nil {
+		return fmt.Errorf("upserting delta state: %v", err)
+	}
+	return nil
+}
+
+func DeleteDeltaState(ctx context.Context, config apiserver.Configuration, resource string) error {
+	if _, err := appdb.DeltaStates(
+		appdb.DeltaStateWhere.ConfigurationID.EQ(null.Int64FromPtr(config.Id).Int64),
+		appdb.DeltaStateWhere.Resource.EQ(resource),
+	).DeleteAllG(ctx); err != nil {
+		return fmt.Errorf("deleting delta state from database: %v", err)
+	}
+	return nil
+}
+
+// GetDirectoryResources returns the rooms and equipment found in the directory so far. An empty
+// purpose returns all of them.
+func GetDirectoryResources(ctx context.Context, config apiserver.Configuration, purpose string) (appdb.DirectoryResourceSlice, error) {
+	mods := []qm.QueryMod{
+		appdb.DirectoryResourceWhere.ConfigurationID.EQ(null.Int64FromPtr(config.Id).Int64),
+	}
+	if purpose != "" {
+		mods = append(mods, appdb.DirectoryResourceWhere.Purpose.EQ(purpose))
+	}
+	resources, err := appdb.DirectoryResources(mods...).AllG(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching directory resources from database: %v", err)
+	}
+	return resources, nil
+}
+
+func UpsertDirectoryResource(ctx context.Context, config apiserver.Configuration, resource *appdb.DirectoryResource) error {
+	resource.ConfigurationID = null.Int64FromPtr(config.Id).Int64
+	if err := resource.UpsertG(ctx, true,
+		[]string{appdb.DirectoryResourceColumns.ConfigurationID, appdb.DirectoryResourceColumns.UserID},
+		boil.Blacklist(appdb.DirectoryResourceColumns.ID, appdb.DirectoryResourceColumns.ConfigurationID, appdb.DirectoryResourceColumns.UserID),
+		boil.Infer(),
+	); err != nil {
+		return fmt.Errorf("upserting directory resource: %v", err)
+	}
+	return nil
+}
+
+func DeleteDirectoryResource(ctx context.Context, config apiserver.Configuration, userID string) error {
+	if _, err := appdb.DirectoryResources(
+		appdb.DirectoryResourceWhere.ConfigurationID.EQ(null.Int64FromPtr(config.Id).Int64),
+		appdb.DirectoryResourceWhere.UserID.EQ(userID),
+	).DeleteAllG(ctx); err != nil {
+		return fmt.Errorf("deleting directory resource from database: %v", err)
+	}
+	return nil
+}
+
+// SetSyncStarted records the start of a synchronization of the configuration.
+func SetSyncStarted(ctx context.Context, config apiserver.Configuration, startedAt time.Time) error {
+	status := appdb.SyncStatus{
+		ConfigurationID: null.Int64FromPtr(config.Id).Int64,
+		LastStartedAt:   null.TimeFrom(startedAt),
+	}
+	if err := status.UpsertG(ctx, true,
+		[]string{appdb.SyncStatusColumns.ConfigurationID},
+		boil.Whitelist(appdb.SyncStatusColumns.LastStartedAt),
+		boil.Whitelist(appdb.SyncStatusColumns.ConfigurationID, appdb.SyncStatusColumns.LastStartedAt),
+	); err != nil {
+		return fmt.Errorf("upserting sync status: %v", err)
+	}
+	return nil
+}
+
+// SetSyncSucceeded records a successful synchronization with the number of collected resources.
+func SetSyncSucceeded(ctx context.Context, config apiserver.Configuration, rooms, equipment int, duration time.Duration) error {
+	if _, err := appdb.SyncStatuses(
+		appdb.SyncStatusWhere.ConfigurationID.EQ(null.Int64FromPtr(config.Id).Int64),
+	).UpdateAllG(ctx, appdb.M{
+		appdb.SyncStatusColumns.LastSucceededAt: time.Now(),
+		appdb.SyncStatusColumns.Rooms:           rooms,
+		appdb.SyncStatusColumns.Equipment:       equipment,
+		appdb.SyncStatusColumns.DurationMS:      duration.Milliseconds(),
+	}); err != nil {
+		return fmt.Errorf("updating sync status: %v", err)
+	}
+	return nil
+}
+
+// SetSyncFailed records why a synchronization failed.
+func SetSyncFailed(ctx context.Context, config apiserver.Configuration, syncErr error) error {
+	if _, err := appdb.SyncStatuses(
+		appdb.SyncStatusWhere.ConfigurationID.EQ(null.Int64FromPtr(config.Id).Int64),
+	).UpdateAllG(ctx, appdb.M{
+		appdb.SyncStatusColumns.LastFailedAt: time.Now(),
+		appdb.SyncStatusColumns.LastError:    syncErr.Error(),
+	}); err != nil {
+		return fmt.Errorf("updating sync status: %v", err)
+	}
+	return nil
+}
+
+// GetSyncStatus returns the synchronization status of the configuration. It is empty if the
+// configuration was not synchronized yet.
+func GetSyncStatus(ctx context.Context, config apiserver.Configuration) (apiserver.SyncStatus, error) {
+	dbStatuses, err := appdb.SyncStatuses(
+		appdb.SyncStatusWhere.ConfigurationID.EQ(null.Int64FromPtr(config.Id).Int64),
+	).AllG(ctx)
+	if err != nil {
+		return apiserver.SyncStatus{}, fmt.Errorf("fetching sync status from database: %v", err)
+	}
+	if len(dbStatuses) == 0 {
+		return apiserver.SyncStatus{}, nil
+	}
+	dbStatus := dbStatuses[0]
+	return apiserver.SyncStatus{
+		LastStartedAt:   dbStatus.LastStartedAt.Ptr(),
+		LastSucceededAt: dbStatus.LastSucceededAt.Ptr(),
+		LastFailedAt:    dbStatus.LastFailedAt.Ptr(),
+		LastError:       dbStatus.LastError.Ptr(),
+		Rooms:           dbStatus.Rooms.Ptr(),
+		Equipment:       dbStatus.Equipment.Ptr(),
+		DurationMs:      dbStatus.DurationMS.Ptr(),
+	}, nil
+}
+
+// PingDatabase tells whether the database answers queries.
+func PingDatabase(ctx context.Context) error {
+	if _, err := queries.Raw("select 1").ExecContext(ctx, boil.GetContextDB()); err != nil {
+		return fmt.Errorf("querying database: %v", err)
+	}
+	return nil
+}
+
+func GetAssetMappings(ctx context.Context, configID int64) ([]apiserver.AssetMapping, error) {
+	dbMappings, err := appdb.AssetMappings(
+		appdb.AssetMappingWhere.ConfigurationID.EQ(configID),
+	).AllG(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching asset mappings from database: %v", err)
+	}
+	apiMappings := make([]apiserver.AssetMapping, 0, len(dbMappings))
+	for _, dbMapping := range dbMappings {
+		apiMappings = append(apiMappings, apiMappingFromDbMapping(dbMapping))
+	}
+	return apiMappings, nil
+}
+
+// UpsertAssetMapping stores the mapping, replacing the one of the same email address in the same
+// project.
+func UpsertAssetMapping(ctx context.Context, configID int64, mapping apiserver.AssetMapping) (apiserver.AssetMapping, error) {
+	dbMapping := appdb.AssetMapping{
+		ConfigurationID: configID,
+		ProjectID:       mapping.ProjectId,
+		Email:           strings.ToLower(mapping.Email),
+		AssetID:         mapping.AssetId,
+	}
+	if err := dbMapping.UpsertG(ctx, true,
+		[]string{appdb.AssetMappingColumns.ConfigurationID, appdb.AssetMappingColumns.ProjectID, appdb.AssetMappingColumns.Email},
+		boil.Whitelist(appdb.AssetMappingColumns.AssetID),
+		boil.Infer(),
+	); err != nil {
+		return apiserver.AssetMapping{}, fmt.Errorf("upserting asset mapping: %v", err)
+	}
+	return apiMappingFromDbMapping(&dbMapping), nil
+}
+
+func DeleteAssetMapping(ctx context.Context, configID int64, mappingID int64) error {
+	count, err := appdb.AssetMappings(
+		appdb.AssetMappingWhere.ConfigurationID.EQ(configID),
+		appdb.AssetMappingWhere.ID.EQ(mappingID),
+	).DeleteAllG(ctx)
+	if err != nil {
+		return fmt.Errorf("deleting asset mapping from database: %v", err)
+	}
+	if count == 0 {
+		return ErrBadRequest
+	}
+	return nil
+}
+
+// GetMappedAssetID returns the Eliona asset the resource is mapped to explicitly, or nil if there
+// is no mapping.
+func GetMappedAssetID(ctx context.Context, config apiserver.Configuration, projId string, email string) (*int32, error) {
+	dbMappings, err := appdb.AssetMappings(
+		appdb.AssetMappingWhere.ConfigurationID.EQ(null.Int64FromPtr(config.Id).Int64),
+		appdb.AssetMappingWhere.ProjectID.EQ(projId),
+		appdb.AssetMappingWhere.Email.EQ(strings.ToLower(email)),
+	).AllG(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching asset mapping from database: %v", err)
+	}
+	if len(dbMappings) == 0 {
+		return nil, nil
+	}
+	return &dbMappings[0].AssetID, nil
+}
+
+func apiMappingFromDbMapping(dbMapping *appdb.AssetMapping) apiserver.AssetMapping {
+	return apiserver.AssetMapping{
+		Id:        common.Ptr(dbMapping.ID),
+		ProjectId: dbMapping.ProjectID,
+		Email:     dbMapping.Email,
+		AssetId:   dbMapping.AssetID,
+	}
+}
+
+func GetPresenceLinks(ctx context.Context, configID int64) ([]apiserver.PresenceLink, error) {
+	dbLinks, err := appdb.PresenceLinks(
+		appdb.PresenceLinkWhere.ConfigurationID.EQ(configID),
+	).AllG(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching presence links from database: %v", err)
+	}
+	apiLinks := make([]apiserver.PresenceLink, 0, len(dbLinks))
+	for _, dbLink := range dbLinks {
+		apiLinks = append(apiLinks, apiLinkFromDbLink(dbLink))
+	}
+	return apiLinks, nil
+}
+
+// UpsertPresenceLink stores the link, replacing the one of the same room.
+func UpsertPresenceLink(ctx context.Context, configID int64, link apiserver.PresenceLink) (apiserver.PresenceLink, error) {
+	dbLink := appdb.PresenceLink{
+		ConfigurationID: configID,
+		Email:           strings.ToLower(link.Email),
+		AssetID:         link.AssetId,
+		Attribute:       link.Attribute,
+		Subtype:         link.Subtype,
+	}
+	if err := dbLink.UpsertG(ctx, true,
+		[]string{appdb.PresenceLinkColumns.ConfigurationID, appdb.PresenceLinkColumns.Email},
+		boil.Whitelist(appdb.PresenceLinkColumns.AssetID, appdb.PresenceLinkColumns.Attribute, appdb.PresenceLinkColumns.Subtype),
+		boil.Infer(),
+	); err != nil {
+		return apiserver.PresenceLink{}, fmt.Errorf("upserting presence link: %v", err)
+	}
+	return apiLinkFromDbLink(&dbLink), nil
+}
+
+func DeletePresenceLink(ctx context.Context, configID int64, linkID int64) error {
+	count, err := appdb.PresenceLinks(
+		appdb.PresenceLinkWhere.ConfigurationID.EQ(configID),
+		appdb.PresenceLinkWhere.ID.EQ(linkID),
+	).DeleteAllG(ctx)
+	if err != nil {
+		return fmt.Errorf("deleting presence link from database: %v", err)
+	}
+	if count == 0 {
+		return ErrBadRequest
+	}
+	return nil
+}
+
+// NoShowEvaluation is the result of checking the presence for the current meeting of a room.
+type NoShowEvaluation struct {
+	// MeetingStart identifies the evaluated meeting.
+	MeetingStart string
+	NoShow       bool
+}
+
+// GetNoShowEvaluations returns the last evaluation of each room with a presence link, by email
+// address.
+func GetNoShowEvaluations(ctx context.Context, configID int64) (map[string]NoShowEvaluation, error) {
+	dbLinks, err := appdb.PresenceLinks(
+		appdb.PresenceLinkWhere.ConfigurationID.EQ(configID),
+	).AllG(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching presence links from database: %v", err)
+	}
+	evaluations := make(map[string]NoShowEvaluation, len(dbLinks))
+	for _, dbLink := range dbLinks {
+		evaluations[dbLink.Email] = NoShowEvaluation{
+			MeetingStart: dbLink.EvaluatedMeetingStart,
+			NoShow:       dbLink.EvaluatedNoShow,
+		}
+	}
+	return evaluations, nil
+}
+
+// ClaimNoShowEvaluation stores the evaluation of the current meeting of the room, unless that
+// meeting has been evaluated already, e.g. by another instance of the app. It tells whether the
+// evaluation was stored, so that only one instance acts on it.
+func ClaimNoShowEvaluation(ctx context.Context, configID int64, email string, evaluation NoShowEvaluation) (bool, error) {
+	count, err := appdb.PresenceLinks(
+		appdb.PresenceLinkWhere.ConfigurationID.EQ(configID),
+		appdb.PresenceLinkWhere.Email.EQ(strings.ToLower(email)),
+		appdb.PresenceLinkWhere.EvaluatedMeetingStart.NEQ(evaluation.MeetingStart),
+	).UpdateAllG(ctx, appdb.M{
+		appdb.PresenceLinkColumns.EvaluatedMeetingStart: evaluation.MeetingStart,
+		appdb.PresenceLinkColumns.EvaluatedNoShow:       evaluation.NoShow,
+	})
+	if err != nil {
+		return false, fmt.Errorf("storing no-show evaluation: %v", err)
+	}
+	return count == 1, nil
+}
+
+// ClearNoShowEvaluation removes the evaluation of the meeting stored by ClaimNoShowEvaluation, so
+// that the meeting is evaluated again, e.g. because acting on the evaluation failed.
+func ClearNoShowEvaluation(ctx context.Context, configID int64, email string, meetingStart string) error {
+	if _, err := appdb.PresenceLinks(
+		appdb.PresenceLinkWhere.ConfigurationID.EQ(configID),
+		appdb.PresenceLinkWhere.Email.EQ(strings.ToLower(email)),
+		appdb.PresenceLinkWhere.EvaluatedMeetingStart.EQ(meetingStart),
+	).UpdateAllG(ctx, appdb.M{
+		appdb.PresenceLinkColumns.EvaluatedMeetingStart: "",
+		appdb.PresenceLinkColumns.EvaluatedNoShow:       false,
+	}); err != nil {
+		return fmt.Errorf("clearing no-show evaluation: %v", err)
+	}
+	return nil
+}
+
+func apiLinkFromDbLink(dbLink *appdb.PresenceLink) apiserver.PresenceLink {
+	return apiserver.PresenceLink{
+		Id:        common.Ptr(dbLink.ID),
+		Email:     dbLink.Email,
+		AssetId:   dbLink.AssetID,
+		Attribute: dbLink.Attribute,
+		Subtype:   dbLink.Subtype,
+	}
+}
@@ -18,20 +18,37 @@ create schema if not exists microsoft_365;
 -- Should be editable by eliona frontend.
 create table if not exists microsoft_365.configuration
 (
-	id               bigserial primary key,
-	client_id        text not null,
-	client_secret    text not null,
-	tenant_id        text not null,
-	username         text not null,
-	password         text not null,
-	for_eliona       boolean not null default false,
-	for_proxy        boolean not null default false,
-	refresh_interval integer not null default 60,
-	request_timeout  integer not null default 120,
-	asset_filter     json,
-	active           boolean default false,
-	enable           boolean default false,
-	project_ids      text[]
+	id                          bigserial primary key,
+	client_id                   text not null,
+	client_secret               text not null,
+	tenant_id                   text not null,
+	username                    text not null,
+	password                    text not null,
+	for_eliona                  boolean not null default false,
+	for_proxy                   boolean not null default false,
+	refresh_interval            integer not null default 60,
+	request_timeout             integer not null default 120,
+	asset_filter                json,
+	active                      boolean default false,
+	enable                      boolean default false,
+	project_ids                 text[],
+	time_zone                   text not null default 'W. Europe Standard Time',
+	schedule_window             integer not null default 60,
+	schedule_interval           integer not null default 30,
+	batch_workers               integer not null default 4,
+	client_certificate          text not null default '',
+	client_certificate_password text not null default '',
+	stale_asset_policy          text not null default 'inactive',
+	asset_mode                  text not null default 'create',
+	mapping_property            text not null default '',
+	working_hours_start         text not null default '08:00',
+	working_hours_end           text not null default '18:00',
+	working_days                text[] not null default '{monday,tuesday,wednesday,thursday,friday}',
+	no_show_timeout             integer not null default 15,
+	no_show_release             boolean not null default false,
+	booking_mode                text not null default 'delegated',
+	booking_account             text not null default '',
+	extend_asset_types          boolean not null default false
 );
 
 create table if not exists microsoft_365.asset
@@ -41,7 +58,84 @@ create table if not exists microsoft_365.asset
 	project_id       text      not null,
 	global_asset_id  text      not null,
 	asset_id         integer,
-	email            text      not null
+	email            text      not null,
+	state            text      not null default 'active',
+	metadata_hash    text      not null default '',
+	mapped           boolean   not null default false,
+	missing_since    timestamp with time zone
+);
+
+create table if not exists microsoft_365.delta_state
+(
+	id                 bigserial primary key,
+	configuration_id   bigint    not null references microsoft_365.configuration(id) ON DELETE CASCADE,
+	resource           text      not null,
+	delta_link         text      not null,
+	rooms_refreshed_at timestamp with time zone not null default now(),
+	failed_user_ids    text[]    not null default '{}',
+	unique (configuration_id, resource)
+);
+
+create table if not exists microsoft_365.directory_resource
+(
+	id               bigserial primary key,
+	configuration_id bigint    not null references microsoft_365.configuration(id) ON DELETE CASCADE,
+	user_id          text      not null,
+	email            text      not null,
+	purpose          text      not null,
+	display_name     text      not null default '',
+	room             json,
+	unique (configuration_id, user_id)
+);
+
+create table if not exists microsoft_365.sync_status
+(
+	id                bigserial primary key,
+	configuration_id  bigint    not null unique references microsoft_365.configuration(id) ON DELETE CASCADE,
+	last_started_at   timestamp with time zone,
+	last_succeeded_at timestamp with time zone,
+	last_failed_at    timestamp with time zone,
+	last_error        text,
+	rooms             integer,
+	equipment         integer,
+	duration_ms       integer
+);
+
+create table if not exists microsoft_365.asset_mapping
+(
+	id               bigserial primary key,
+	configuration_id bigint    not null references microsoft_365.configuration(id) ON DELETE CASCADE,
+	project_id       text      not null,
+	email            text      not null,
+	asset_id         integer   not null,
+	unique (configuration_id, project_id, email)
+);
+
+create table if not exists microsoft_365.presence_link
+(
+	id                      bigserial primary key,
+	configuration_id        bigint    not null references microsoft_365.configuration(id) ON DELETE CASCADE,
+	email                   text      not null,
+	asset_id                integer   not null,
+	attribute               text      not null,
+	subtype                 text      not null default 'input',
+	evaluated_meeting_start text      not null default '',
+	evaluated_no_show       boolean   not null default false,
+	unique (configuration_id, email)
+);
+
+create table if not exists microsoft_365.booking_session
+(
+	id                bigserial primary key,
+	configuration_id  bigint    not null references microsoft_365.configuration(id) ON DELETE CASCADE,
+	code_hash         text      not null unique,
+	asset_id          integer   not null,
+	user_name         text      not null default '',
+	token_cache       text      not null default '',
+	created_at        timestamp with time zone not null default now(),
+	user_display_name text      not null default '',
+	status            text      not null default 'pending',
+	code_expires_at   timestamp with time zone
 );
 
 -- Makes the new objects available for all other init steps
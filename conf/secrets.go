@@ -0,0 +1,245 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package conf
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"microsoft-365/appdb"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// Secrets encrypted with AES-GCM are stored as "enc:<key ID>:<base64 of nonce and ciphertext>".
+// Values without the prefix are plaintext, stored before encryption was enabled.
+const encryptedPrefix = "enc:"
+
+type secretKey struct {
+	id   string
+	aead cipher.AEAD
+}
+
+var secretKeys = struct {
+	once    sync.Once
+	current *secretKey
+	keys    map[string]*secretKey
+	loadErr error
+}{}
+
+// loadSecretKeys reads the current key from CONFIG_ENCRYPTION_KEY and keys used before from the
+// comma separated CONFIG_ENCRYPTION_KEY_PREVIOUS. Keys are base64 encoded and 32 bytes long.
+func loadSecretKeys() error {
+	secretKeys.once.Do(func() {
+		secretKeys.keys = make(map[string]*secretKey)
+		if current := strings.TrimSpace(os.Getenv("CONFIG_ENCRYPTION_KEY")); current != "" {
+			key, err := newSecretKey(current)
+			if err != nil {
+				secretKeys.loadErr = fmt.Errorf("CONFIG_ENCRYPTION_KEY: %v", err)
+				return
+			}
+			secretKeys.current = key
+			secretKeys.keys[key.id] = key
+		}
+		for _, previous := range strings.Split(os.Getenv("CONFIG_ENCRYPTION_KEY_PREVIOUS"), ",") {
+			if previous = strings.TrimSpace(previous); previous == "" {
+				continue
+			}
+			key, err := newSecretKey(previous)
+			if err != nil {
+				secretKeys.loadErr = fmt.Errorf("CONFIG_ENCRYPTION_KEY_PREVIOUS: %v", err)
+				return
+			}
+			if _, ok := secretKeys.keys[key.id]; !ok {
+				secretKeys.keys[key.id] = key
+			}
+		}
+	})
+	return secretKeys.loadErr
+}
+
+func newSecretKey(encoded string) (*secretKey, error) {
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("decoding key: %v", err)
+	}
+	if len(raw) != 32 {
+		return nil, fmt.Errorf("key must be 32 bytes long, is %d", len(raw))
+	}
+	block, err := aes.NewCipher(raw)
+	if err != nil {
+		return nil, fmt.Errorf("creating cipher: %v", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("creating GCM: %v", err)
+	}
+	sum := sha256.Sum256(raw)
+	return &secretKey{id: hex.EncodeToString(sum[:4]), aead: aead}, nil
+}
+
+// ErrNoEncryptionKey is returned for values that must not be stored unencrypted.
+var ErrNoEncryptionKey = errors.New("CONFIG_ENCRYPTION_KEY is not set")
+
+// EncryptionEnabled tells whether a key to encrypt secrets is configured.
+func EncryptionEnabled() (bool, error) {
+	if err := loadSecretKeys(); err != nil {
+		return false, err
+	}
+	return secretKeys.current != nil, nil
+}
+
+// encryptSecret encrypts the value with the current key. Without a key, the value is returned
+// unchanged and an error is logged, as the secrets of configurations stored before encryption was
+// introduced must keep working.
+func encryptSecret(plaintext string) (string, error) {
+	if err := loadSecretKeys(); err != nil {
+		return "", err
+	}
+	key := secretKeys.current
+	if plaintext == "" {
+		return plaintext, nil
+	}
+	if key == nil {
+		log.Error("conf", "CONFIG_ENCRYPTION_KEY is not set, storing a secret unencrypted.")
+		return plaintext, nil
+	}
+	nonce := make([]byte, key.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("generating nonce: %v", err)
+	}
+	sealed := key.aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return encryptedPrefix + key.id + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// encryptRequiredSecret encrypts the value like encryptSecret, but fails instead of returning the
+// plaintext if no key is configured.
+func encryptRequiredSecret(plaintext string) (string, error) {
+	enabled, err := EncryptionEnabled()
+	if err != nil {
+		return "", err
+	}
+	if !enabled {
+		return "", ErrNoEncryptionKey
+	}
+	return encryptSecret(plaintext)
+}
+
+// decryptSecret decrypts a value encrypted with the current or a previous key. Plaintext values are
+// returned unchanged.
+func decryptSecret(stored string) (string, error) {
+	if !strings.HasPrefix(stored, encryptedPrefix) {
+		return stored, nil
+	}
+	if err := loadSecretKeys(); err != nil {
+		return "", err
+	}
+	keyID, encoded, ok := strings.Cut(strings.TrimPrefix(stored, encryptedPrefix), ":")
+	if !ok {
+		return "", fmt.Errorf("malformed encrypted secret")
+	}
+	key, ok := secretKeys.keys[keyID]
+	if !ok {
+		return "", fmt.Errorf("secret was encrypted with unknown key %s", keyID)
+	}
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("decoding encrypted secret: %v", err)
+	}
+	if len(sealed) < key.aead.NonceSize() {
+		return "", fmt.Errorf("malformed encrypted secret")
+	}
+	nonce, ciphertext := sealed[:key.aead.NonceSize()], sealed[key.aead.NonceSize():]
+	plaintext, err := key.aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", fmt.Errorf("decrypting secret: %v", err)
+	}
+	return string(plaintext), nil
+}
+
+// isEncryptedWithCurrentKey tells whether the stored value needs no re-encryption.
+func isEncryptedWithCurrentKey(stored string) bool {
+	if stored == "" {
+		return true
+	}
+	return secretKeys.current != nil && strings.HasPrefix(stored, encryptedPrefix+secretKeys.current.id+":")
+}
+
+// EncryptStoredSecrets encrypts the secrets of all configurations and the token caches of booking
+// sessions with the current key. This encrypts secrets stored in plaintext and completes the
+// rotation to a new key, after which the previous key can be removed.
+func EncryptStoredSecrets(ctx context.Context) error {
+	if err := loadSecretKeys(); err != nil {
+		return err
+	}
+	if secretKeys.current == nil {
+		log.Error("conf", "CONFIG_ENCRYPTION_KEY is not set, secrets of configurations are stored unencrypted and bookings with device codes are disabled.")
+		return clearStoredTokenCaches(ctx)
+	}
+	dbConfigs, err := appdb.Configurations().AllG(ctx)
+	if err != nil {
+		return fmt.Errorf("fetching configs from database: %v", err)
+	}
+	for _, dbConfig := range dbConfigs {
+		changed := false
+		for _, secret := range secretFields(dbConfig) {
+			if isEncryptedWithCurrentKey(*secret) {
+				continue
+			}
+			plaintext, err := decryptSecret(*secret)
+			if err != nil {
+				return fmt.Errorf("decrypting secret of config %d: %v", dbConfig.ID, err)
+			}
+			if *secret, err = encryptSecret(plaintext); err != nil {
+				return fmt.Errorf("encrypting secret of config %d: %v", dbConfig.ID, err)
+			}
+			changed = true
+		}
+		if !changed {
+			continue
+		}
+		if _, err := dbConfig.UpdateG(ctx, boil.Whitelist(
+			appdb.ConfigurationColumns.ClientSecret,
+			appdb.ConfigurationColumns.Password,
+			appdb.ConfigurationColumns.ClientCertificate,
+			appdb.ConfigurationColumns.ClientCertificatePassword,
+		)); err != nil {
+			return fmt.Errorf("updating secrets of config %d: %v", dbConfig.ID, err)
+		}
+		log.Info("conf", "Encrypted secrets of configuration %d with the current key.", dbConfig.ID)
+	}
+	return encryptStoredTokenCaches(ctx)
+}
+
+// secretFields returns the columns of the configuration which are stored encrypted.
+func secretFields(dbConfig *appdb.Configuration) []*string {
+	return []*string{
+		&dbConfig.ClientSecret,
+		&dbConfig.Password,
+		&dbConfig.ClientCertificate,
+		&dbConfig.ClientCertificatePassword,
+	}
+}
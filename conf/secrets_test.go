@@ -0,0 +1,172 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package conf
+
+import (
+	"encoding/base64"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const (
+	testKey      = "AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8="
+	otherTestKey = "HxwdHhscGRgXFhUUExIREA8ODQwLCgkIBwYFBAMCAQA="
+)
+
+// useSecretKeys sets the encryption keys and makes the next call load them again.
+func useSecretKeys(t *testing.T, current, previous string) {
+	t.Helper()
+	t.Setenv("CONFIG_ENCRYPTION_KEY", current)
+	t.Setenv("CONFIG_ENCRYPTION_KEY_PREVIOUS", previous)
+	resetSecretKeys()
+	t.Cleanup(resetSecretKeys)
+}
+
+func resetSecretKeys() {
+	secretKeys.once = sync.Once{}
+	secretKeys.current = nil
+	secretKeys.keys = nil
+	secretKeys.loadErr = nil
+}
+
+func TestEncryptDecryptSecret(t *testing.T) {
+	useSecretKeys(t, testKey, "")
+
+	encrypted, err := encryptSecret("client secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(encrypted, encryptedPrefix) || strings.Contains(encrypted, "client secret") {
+		t.Errorf("encryptSecret returned %s, want an encrypted value", encrypted)
+	}
+	if !isEncryptedWithCurrentKey(encrypted) {
+		t.Errorf("%s is not encrypted with the current key", encrypted)
+	}
+	again, err := encryptSecret("client secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again == encrypted {
+		t.Error("encrypting the same value twice gave the same result, want a new nonce")
+	}
+
+	decrypted, err := decryptSecret(encrypted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decrypted != "client secret" {
+		t.Errorf("decryptSecret = %q, want %q", decrypted, "client secret")
+	}
+}
+
+func TestEncryptSecretEmpty(t *testing.T) {
+	useSecretKeys(t, testKey, "")
+
+	encrypted, err := encryptSecret("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if encrypted != "" {
+		t.Errorf("encryptSecret of an empty value = %q, want it empty", encrypted)
+	}
+}
+
+func TestEncryptSecretWithoutKey(t *testing.T) {
+	useSecretKeys(t, "", "")
+
+	encrypted, err := encryptSecret("client secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if encrypted != "client secret" {
+		t.Errorf("encryptSecret without key = %q, want the plaintext", encrypted)
+	}
+}
+
+func TestEncryptRequiredSecretWithoutKey(t *testing.T) {
+	useSecretKeys(t, "", "")
+
+	if _, err := encryptRequiredSecret("token cache"); err != ErrNoEncryptionKey {
+		t.Errorf("encryptRequiredSecret without key returned %v, want %v", err, ErrNoEncryptionKey)
+	}
+}
+
+func TestDecryptSecretPlaintext(t *testing.T) {
+	useSecretKeys(t, testKey, "")
+
+	decrypted, err := decryptSecret("stored before encryption")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decrypted != "stored before encryption" {
+		t.Errorf("decryptSecret = %q, want the plaintext unchanged", decrypted)
+	}
+}
+
+func TestDecryptSecretWithPreviousKey(t *testing.T) {
+	useSecretKeys(t, otherTestKey, "")
+	encrypted, err := encryptSecret("client secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	useSecretKeys(t, testKey, otherTestKey)
+	if isEncryptedWithCurrentKey(encrypted) {
+		t.Errorf("%s is encrypted with the current key, want the previous one", encrypted)
+	}
+	decrypted, err := decryptSecret(encrypted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decrypted != "client secret" {
+		t.Errorf("decryptSecret = %q, want %q", decrypted, "client secret")
+	}
+
+	useSecretKeys(t, testKey, "")
+	if _, err := decryptSecret(encrypted); err == nil {
+		t.Error("decryptSecret with an unknown key succeeded, want error")
+	}
+}
+
+func TestDecryptSecretTampered(t *testing.T) {
+	useSecretKeys(t, testKey, "")
+	encrypted, err := encryptSecret("client secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyID, encoded, _ := strings.Cut(strings.TrimPrefix(encrypted, encryptedPrefix), ":")
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sealed[len(sealed)-1] ^= 1
+	tampered := encryptedPrefix + keyID + ":" + base64.StdEncoding.EncodeToString(sealed)
+
+	for _, stored := range []string{tampered, encryptedPrefix + keyID, encryptedPrefix + keyID + ":AAAA"} {
+		if _, err := decryptSecret(stored); err == nil {
+			t.Errorf("decryptSecret(%q) succeeded, want error", stored)
+		}
+	}
+}
+
+func TestInvalidSecretKey(t *testing.T) {
+	useSecretKeys(t, base64.StdEncoding.EncodeToString([]byte("too short")), "")
+
+	if _, err := encryptSecret("client secret"); err == nil {
+		t.Error("encryptSecret with an invalid key succeeded, want error")
+	}
+}
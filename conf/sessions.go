@@ -0,0 +1,254 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package conf
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"microsoft-365/apiserver"
+	"microsoft-365/appdb"
+	"time"
+
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// BookingSessionDuration is how long a device code stays authorized for bookings.
+const BookingSessionDuration = 7 * 24 * time.Hour
+
+// States of the sign-in with the device code of a booking session.
+const (
+	BookingSessionPending    = "pending"
+	BookingSessionAuthorized = "authorized"
+	BookingSessionFailed     = "failed"
+	BookingSessionExpired    = "expired"
+)
+
+// Device codes authorize bookings, so only their hashes are stored.
+func hashDeviceCode(deviceCode string) string {
+	sum := sha256.Sum256([]byte(deviceCode))
+	return hex.EncodeToString(sum[:])
+}
+
+func InsertBookingSession(ctx context.Context, configID int64, assetID int32, deviceCode string, codeExpiresAt time.Time) error {
+	dbSession := appdb.BookingSession{
+		ConfigurationID: configID,
+		CodeHash:        hashDeviceCode(deviceCode),
+		AssetID:         assetID,
+		Status:          BookingSessionPending,
+		CodeExpiresAt:   null.TimeFrom(codeExpiresAt),
+	}
+	if err := dbSession.InsertG(ctx, boil.Infer()); err != nil {
+		return fmt.Errorf("inserting booking session: %v", err)
+	}
+	return nil
+}
+
+// GetBookingSession returns the session of the device code, or nil if there is none or it has
+// expired.
+func GetBookingSession(ctx context.Context, deviceCode string) (*appdb.BookingSession, error) {
+	dbSession, err := appdb.BookingSessions(
+		appdb.BookingSessionWhere.CodeHash.EQ(hashDeviceCode(deviceCode)),
+		appdb.BookingSessionWhere.CreatedAt.GT(time.Now().Add(-BookingSessionDuration)),
+	).OneG(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("fetching booking session from database: %v", err)
+	}
+	return dbSession, nil
+}
+
+// GetBookingSessionStatus returns the session of the device code including expired ones, or nil
+// if there is none.
+func GetBookingSessionStatus(ctx context.Context, deviceCode string) (*apiserver.BookingSession, error) {
+	dbSession, err := appdb.BookingSessions(
+		appdb.BookingSessionWhere.CodeHash.EQ(hashDeviceCode(deviceCode)),
+	).OneG(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("fetching booking session from database: %v", err)
+	}
+	apiSession := apiSessionFromDbSession(dbSession)
+	return &apiSession, nil
+}
+
+// SetBookingSessionAuthorized records the user who signed in with the device code.
+func SetBookingSessionAuthorized(ctx context.Context, deviceCode string, userName string, displayName string) error {
+	if _, err := appdb.BookingSessions(
+		appdb.BookingSessionWhere.CodeHash.EQ(hashDeviceCode(deviceCode)),
+	).UpdateAllG(ctx, appdb.M{
+		appdb.BookingSessionColumns.Status:          BookingSessionAuthorized,
+		appdb.BookingSessionColumns.UserName:        userName,
+		appdb.BookingSessionColumns.UserDisplayName: displayName,
+	}); err != nil {
+		return fmt.Errorf("updating user of booking session: %v", err)
+	}
+	return nil
+}
+
+// SetBookingSessionStatus records that the sign-in with the device code failed or expired.
+func SetBookingSessionStatus(ctx context.Context, deviceCode string, status string) error {
+	if _, err := appdb.BookingSessions(
+		appdb.BookingSessionWhere.CodeHash.EQ(hashDeviceCode(deviceCode)),
+	).UpdateAllG(ctx, appdb.M{appdb.BookingSessionColumns.Status: status}); err != nil {
+		return fmt.Errorf("updating status of booking session: %v", err)
+	}
+	return nil
+}
+
+// GetBookingSessionTokenCache returns the decrypted token cache of the session, or nil if the
+// session has none yet or does not exist anymore.
+func GetBookingSessionTokenCache(ctx context.Context, deviceCode string) ([]byte, error) {
+	dbSession, err := GetBookingSession(ctx, deviceCode)
+	if err != nil {
+		return nil, err
+	}
+	if dbSession == nil || dbSession.TokenCache == "" {
+		return nil, nil
+	}
+	tokenCache, err := decryptSecret(dbSession.TokenCache)
+	if err != nil {
+		return nil, fmt.Errorf("decrypting token cache: %v", err)
+	}
+	return []byte(tokenCache), nil
+}
+
+// SetBookingSessionTokenCache stores the token cache of the session encrypted. The tokens are never
+// stored in plaintext, without a key ErrNoEncryptionKey is returned. Revoked sessions are not
+// recreated.
+func SetBookingSessionTokenCache(ctx context.Context, deviceCode string, tokenCache []byte) error {
+	encrypted, err := encryptRequiredSecret(string(tokenCache))
+	if err != nil {
+		return fmt.Errorf("encrypting token cache: %v", err)
+	}
+	if _, err := appdb.BookingSessions(
+		appdb.BookingSessionWhere.CodeHash.EQ(hashDeviceCode(deviceCode)),
+	).UpdateAllG(ctx, appdb.M{appdb.BookingSessionColumns.TokenCache: encrypted}); err != nil {
+		return fmt.Errorf("updating token cache of booking session: %v", err)
+	}
+	return nil
+}
+
+// GetBookingSessions returns the sessions that have not expired yet.
+func GetBookingSessions(ctx context.Context) ([]apiserver.BookingSession, error) {
+	dbSessions, err := appdb.BookingSessions(
+		appdb.BookingSessionWhere.CreatedAt.GT(time.Now().Add(-BookingSessionDuration)),
+	).AllG(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching booking sessions from database: %v", err)
+	}
+	apiSessions := make([]apiserver.BookingSession, 0, len(dbSessions))
+	for _, dbSession := range dbSessions {
+		apiSessions = append(apiSessions, apiSessionFromDbSession(dbSession))
+	}
+	return apiSessions, nil
+}
+
+func apiSessionFromDbSession(dbSession *appdb.BookingSession) apiserver.BookingSession {
+	status := dbSession.Status
+	if status == BookingSessionPending && dbSession.CodeExpiresAt.Valid && time.Now().After(dbSession.CodeExpiresAt.Time) {
+		// The app instance waiting for the sign-in may have stopped before recording it.
+		status = BookingSessionExpired
+	}
+	if time.Since(dbSession.CreatedAt) > BookingSessionDuration {
+		status = BookingSessionExpired
+	}
+	return apiserver.BookingSession{
+		Id:              dbSession.ID,
+		ConfigId:        dbSession.ConfigurationID,
+		AssetId:         dbSession.AssetID,
+		Status:          status,
+		Authorized:      status == BookingSessionAuthorized,
+		UserName:        dbSession.UserName,
+		UserDisplayName: dbSession.UserDisplayName,
+		CreatedAt:       dbSession.CreatedAt,
+		ExpiresAt:       dbSession.CreatedAt.Add(BookingSessionDuration),
+	}
+}
+
+func DeleteBookingSession(ctx context.Context, sessionID int64) error {
+	count, err := appdb.BookingSessions(
+		appdb.BookingSessionWhere.ID.EQ(sessionID),
+	).DeleteAllG(ctx)
+	if err != nil {
+		return fmt.Errorf("deleting booking session from database: %v", err)
+	}
+	if count == 0 {
+		return ErrBadRequest
+	}
+	return nil
+}
+
+func DeleteExpiredBookingSessions(ctx context.Context) error {
+	count, err := appdb.BookingSessions(
+		appdb.BookingSessionWhere.CreatedAt.LTE(time.Now().Add(-BookingSessionDuration)),
+	).DeleteAllG(ctx)
+	if err != nil {
+		return fmt.Errorf("deleting expired booking sessions from database: %v", err)
+	}
+	if count > 0 {
+		log.Debug("conf", "Deleted %d expired booking sessions.", count)
+	}
+	return nil
+}
+
+// clearStoredTokenCaches removes token caches that would otherwise stay unencrypted, which ends the
+// affected booking sessions.
+func clearStoredTokenCaches(ctx context.Context) error {
+	cleared, err := appdb.BookingSessions(
+		appdb.BookingSessionWhere.TokenCache.NEQ(""),
+	).UpdateAllG(ctx, appdb.M{appdb.BookingSessionColumns.TokenCache: ""})
+	if err != nil {
+		return fmt.Errorf("clearing token caches of booking sessions: %v", err)
+	}
+	if cleared > 0 {
+		log.Warn("conf", "Removed the tokens of %d booking sessions, as they cannot be stored encrypted.", cleared)
+	}
+	return nil
+}
+
+// encryptStoredTokenCaches encrypts the token caches of all booking sessions with the current key.
+func encryptStoredTokenCaches(ctx context.Context) error {
+	dbSessions, err := appdb.BookingSessions().AllG(ctx)
+	if err != nil {
+		return fmt.Errorf("fetching booking sessions from database: %v", err)
+	}
+	for _, dbSession := range dbSessions {
+		if isEncryptedWithCurrentKey(dbSession.TokenCache) {
+			continue
+		}
+		plaintext, err := decryptSecret(dbSession.TokenCache)
+		if err != nil {
+			return fmt.Errorf("decrypting token cache of booking session %d: %v", dbSession.ID, err)
+		}
+		if dbSession.TokenCache, err = encryptSecret(plaintext); err != nil {
+			return fmt.Errorf("encrypting token cache of booking session %d: %v", dbSession.ID, err)
+		}
+		if _, err := dbSession.UpdateG(ctx, boil.Whitelist(appdb.BookingSessionColumns.TokenCache)); err != nil {
+			return fmt.Errorf("updating token cache of booking session %d: %v", dbSession.ID, err)
+		}
+	}
+	return nil
+}
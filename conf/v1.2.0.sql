@@ -0,0 +1,113 @@
+--  This file is part of the eliona project.
+--  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+--  ______ _ _
+-- |  ____| (_)
+-- | |__  | |_  ___  _ __   __ _
+-- |  __| | | |/ _ \| '_ \ / _` |
+-- | |____| | | (_) | | | | (_| |
+-- |______|_|_|\___/|_| |_|\__,_|
+--
+--  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+--  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+--  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+--  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+--  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+alter table microsoft_365.configuration
+	add column if not exists time_zone text not null default 'W. Europe Standard Time',
+	add column if not exists schedule_window integer not null default 60,
+	add column if not exists schedule_interval integer not null default 30,
+	add column if not exists batch_workers integer not null default 4,
+	add column if not exists client_certificate text not null default '',
+	add column if not exists client_certificate_password text not null default '',
+	add column if not exists stale_asset_policy text not null default 'inactive',
+	add column if not exists asset_mode text not null default 'create',
+	add column if not exists mapping_property text not null default '',
+	add column if not exists working_hours_start text not null default '08:00',
+	add column if not exists working_hours_end text not null default '18:00',
+	add column if not exists working_days text[] not null default '{monday,tuesday,wednesday,thursday,friday}',
+	add column if not exists no_show_timeout integer not null default 15,
+	add column if not exists no_show_release boolean not null default false,
+	add column if not exists booking_mode text not null default 'delegated',
+	add column if not exists booking_account text not null default '',
+	add column if not exists extend_asset_types boolean not null default false;
+
+alter table microsoft_365.asset
+	add column if not exists state text not null default 'active',
+	add column if not exists metadata_hash text not null default '',
+	add column if not exists mapped boolean not null default false,
+	add column if not exists missing_since timestamp with time zone;
+
+
+create table if not exists microsoft_365.delta_state
+(
+	id                 bigserial primary key,
+	configuration_id   bigint    not null references microsoft_365.configuration(id) ON DELETE CASCADE,
+	resource           text      not null,
+	delta_link         text      not null,
+	rooms_refreshed_at timestamp with time zone not null default now(),
+	failed_user_ids    text[]    not null default '{}',
+	unique (configuration_id, resource)
+);
+
+create table if not exists microsoft_365.directory_resource
+(
+	id               bigserial primary key,
+	configuration_id bigint    not null references microsoft_365.configuration(id) ON DELETE CASCADE,
+	user_id          text      not null,
+	email            text      not null,
+	purpose          text      not null,
+	display_name     text      not null default '',
+	room             json,
+	unique (configuration_id, user_id)
+);
+
+create table if not exists microsoft_365.sync_status
+(
+	id                bigserial primary key,
+	configuration_id  bigint    not null unique references microsoft_365.configuration(id) ON DELETE CASCADE,
+	last_started_at   timestamp with time zone,
+	last_succeeded_at timestamp with time zone,
+	last_failed_at    timestamp with time zone,
+	last_error        text,
+	rooms             integer,
+	equipment         integer,
+	duration_ms       integer
+);
+
+create table if not exists microsoft_365.asset_mapping
+(
+	id               bigserial primary key,
+	configuration_id bigint    not null references microsoft_365.configuration(id) ON DELETE CASCADE,
+	project_id       text      not null,
+	email            text      not null,
+	asset_id         integer   not null,
+	unique (configuration_id, project_id, email)
+);
+
+create table if not exists microsoft_365.presence_link
+(
+	id                      bigserial primary key,
+	configuration_id        bigint    not null references microsoft_365.configuration(id) ON DELETE CASCADE,
+	email                   text      not null,
+	asset_id                integer   not null,
+	attribute               text      not null,
+	subtype                 text      not null default 'input',
+	evaluated_meeting_start text      not null default '',
+	evaluated_no_show       boolean   not null default false,
+	unique (configuration_id, email)
+);
+
+create table if not exists microsoft_365.booking_session
+(
+	id                bigserial primary key,
+	configuration_id  bigint    not null references microsoft_365.configuration(id) ON DELETE CASCADE,
+	code_hash         text      not null unique,
+	asset_id          integer   not null,
+	user_name         text      not null default '',
+	token_cache       text      not null default '',
+	created_at        timestamp with time zone not null default now(),
+	user_display_name text      not null default '',
+	status            text      not null default 'pending',
+	code_expires_at   timestamp with time zone
+);
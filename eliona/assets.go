@@ -17,31 +17,62 @@ package eliona
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"microsoft-365/apiserver"
+	"microsoft-365/appdb"
 	"microsoft-365/conf"
 	"microsoft-365/msgraph"
+	"net/http"
+	"strings"
 
 	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
 	"github.com/eliona-smart-building-assistant/go-eliona/asset"
+	"github.com/eliona-smart-building-assistant/go-eliona/client"
 	"github.com/eliona-smart-building-assistant/go-utils/common"
 	"github.com/eliona-smart-building-assistant/go-utils/log"
 )
 
-func CreateRoomsAssetsIfNecessary(config apiserver.Configuration, rooms []msgraph.Room) error {
+// CreateRoomsAssetsIfNecessary creates the assets of the rooms. Rooms in a room list get the asset
+// of the room list as functional parent; rooms in several room lists get the first one.
+func CreateRoomsAssetsIfNecessary(config apiserver.Configuration, rooms []msgraph.Room, roomLists []msgraph.RoomList) error {
+	roomListsByEmail := make(map[string]msgraph.RoomList)
+	for _, roomList := range roomLists {
+		roomListsByEmail[strings.ToLower(roomList.EmailAddress)] = roomList
+	}
 	for _, projectId := range conf.ProjIds(config) {
 		rootAssetID, err := upsertRootAsset(config, projectId)
 		if err != nil {
 			return fmt.Errorf("upserting root asset: %v", err)
 		}
+		parents := make(map[string]int32)
 		for _, room := range rooms {
 			id := room.EmailAddress
 			assetType := "microsoft_365_room"
 			name := room.DisplayName
-			_, _, err := upsertAsset(assetData{
+			parentAssetID, err := upsertParentAssets(config, projectId, rootAssetID, room.Location(), parents)
+			if err != nil {
+				return fmt.Errorf("upserting building and floor of room %s: %v", *id, err)
+			}
+			var functionalParentAssetID *int32
+			for _, email := range strings.Split(room.RoomLists, ",") {
+				roomList, ok := roomListsByEmail[email]
+				if !ok {
+					continue
+				}
+				roomListAssetID, err := upsertRoomListAsset(config, projectId, rootAssetID, roomList, parents)
+				if err != nil {
+					return fmt.Errorf("upserting room list %s: %v", email, err)
+				}
+				functionalParentAssetID = &roomListAssetID
+				break
+			}
+			_, _, err = upsertAsset(assetData{
 				config:                  config,
 				projectId:               projectId,
-				parentLocationalAssetId: &rootAssetID,
+				parentFunctionalAssetId: functionalParentAssetID,
+				parentLocationalAssetId: &parentAssetID,
 				identifier:              fmt.Sprintf("%s_%s", assetType, *id),
 				assetType:               assetType,
 				name:                    *name,
@@ -84,6 +115,76 @@ func CreateEquipmentAssetsIfNecessary(config apiserver.Configuration, equipmentL
 	return nil
 }
 
+func CreateWorkspaceAssetsIfNecessary(config apiserver.Configuration, workspaces []msgraph.Workspace) error {
+	for _, projectId := range conf.ProjIds(config) {
+		rootAssetID, err := upsertRootAsset(config, projectId)
+		if err != nil {
+			return fmt.Errorf("upserting root asset: %v", err)
+		}
+		parents := make(map[string]int32)
+		for _, workspace := range workspaces {
+			id := workspace.EmailAddress
+			assetType := "microsoft_365_workspace"
+			name := workspace.DisplayName
+			parentAssetID, err := upsertParentAssets(config, projectId, rootAssetID, workspace.Location(), parents)
+			if err != nil {
+				return fmt.Errorf("upserting building and floor of workspace %s: %v", *id, err)
+			}
+			_, _, err = upsertAsset(assetData{
+				config:                  config,
+				projectId:               projectId,
+				parentLocationalAssetId: &parentAssetID,
+				identifier:              fmt.Sprintf("%s_%s", assetType, *id),
+				assetType:               assetType,
+				name:                    *name,
+				description:             fmt.Sprintf("%s (%v)", *name, *id),
+				email:                   *workspace.EmailAddress,
+			})
+			if err != nil {
+				return fmt.Errorf("upserting workspace %s: %v", *id, err)
+			}
+		}
+	}
+	return nil
+}
+
+// CreateDeskAssetsIfNecessary creates the assets of the desks. Desks are placed under the root
+// asset, as Microsoft Places puts them into sections that have no counterpart in Eliona.
+func CreateDeskAssetsIfNecessary(config apiserver.Configuration, desks []msgraph.Desk) error {
+	for _, projectId := range conf.ProjIds(config) {
+		rootAssetID, err := upsertRootAsset(config, projectId)
+		if err != nil {
+			return fmt.Errorf("upserting root asset: %v", err)
+		}
+		for _, desk := range desks {
+			id := desk.PlaceID
+			assetType := "microsoft_365_desk"
+			name := *id
+			if desk.DisplayName != nil {
+				name = *desk.DisplayName
+			}
+			var email string
+			if desk.EmailAddress != nil {
+				email = *desk.EmailAddress
+			}
+			_, _, err := upsertAsset(assetData{
+				config:                  config,
+				projectId:               projectId,
+				parentLocationalAssetId: &rootAssetID,
+				identifier:              fmt.Sprintf("%s_%s", assetType, *id),
+				assetType:               assetType,
+				name:                    name,
+				description:             fmt.Sprintf("Desk %s", name),
+				email:                   email,
+			})
+			if err != nil {
+				return fmt.Errorf("upserting desk %s: %v", *id, err)
+			}
+		}
+	}
+	return nil
+}
+
 func upsertRootAsset(config apiserver.Configuration, projectId string) (int32, error) {
 	_, rootAssetID, err := upsertAsset(assetData{
 		config:                  config,
@@ -97,6 +198,95 @@ func upsertRootAsset(config apiserver.Configuration, projectId string) (int32, e
 	return rootAssetID, err
 }
 
+// upsertParentAssets creates the assets of the building and floor a room or workspace is on and
+// returns the one it belongs under. Places without a building stay under the root asset. The asset
+// IDs are cached in parents by identifier.
+func upsertParentAssets(config apiserver.Configuration, projectId string, rootAssetID int32, location msgraph.Location, parents map[string]int32) (int32, error) {
+	if location.Building == nil || *location.Building == "" {
+		return rootAssetID, nil
+	}
+	building := *location.Building
+	buildingIdentifier := fmt.Sprintf("microsoft_365_building_%s", building)
+	buildingAssetID, ok := parents[buildingIdentifier]
+	if !ok {
+		_, id, err := upsertAsset(assetData{
+			config:                  config,
+			projectId:               projectId,
+			parentLocationalAssetId: &rootAssetID,
+			identifier:              buildingIdentifier,
+			assetType:               "microsoft_365_building",
+			name:                    building,
+			description:             fmt.Sprintf("Building %s", building),
+		})
+		if err != nil {
+			return 0, fmt.Errorf("upserting building %s: %v", building, err)
+		}
+		parents[buildingIdentifier] = id
+		buildingAssetID = id
+	}
+
+	// Floors are identified by their number, falling back to the label.
+	var floorKey, floorName string
+	switch {
+	case location.FloorNumber != nil:
+		floorKey = fmt.Sprint(*location.FloorNumber)
+		floorName = fmt.Sprintf("Floor %d", *location.FloorNumber)
+		if location.FloorLabel != nil && *location.FloorLabel != "" {
+			floorName = *location.FloorLabel
+		}
+	case location.FloorLabel != nil && *location.FloorLabel != "":
+		floorKey = *location.FloorLabel
+		floorName = *location.FloorLabel
+	default:
+		return buildingAssetID, nil
+	}
+	floorIdentifier := fmt.Sprintf("microsoft_365_floor_%s_%s", building, floorKey)
+	if floorAssetID, ok := parents[floorIdentifier]; ok {
+		return floorAssetID, nil
+	}
+	_, floorAssetID, err := upsertAsset(assetData{
+		config:                  config,
+		projectId:               projectId,
+		parentLocationalAssetId: &buildingAssetID,
+		identifier:              floorIdentifier,
+		assetType:               "microsoft_365_floor",
+		name:                    floorName,
+		description:             fmt.Sprintf("%s, %s", floorName, building),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("upserting floor %s of building %s: %v", floorKey, building, err)
+	}
+	parents[floorIdentifier] = floorAssetID
+	return floorAssetID, nil
+}
+
+// upsertRoomListAsset creates the asset of the room list as functional child of the root asset.
+// The asset ID is cached in parents by identifier.
+func upsertRoomListAsset(config apiserver.Configuration, projectId string, rootAssetID int32, roomList msgraph.RoomList, parents map[string]int32) (int32, error) {
+	identifier := roomList.Id()
+	if id, ok := parents[identifier]; ok {
+		return id, nil
+	}
+	name := roomList.DisplayName
+	if name == "" {
+		name = roomList.EmailAddress
+	}
+	_, id, err := upsertAsset(assetData{
+		config:                  config,
+		projectId:               projectId,
+		parentFunctionalAssetId: &rootAssetID,
+		identifier:              identifier,
+		assetType:               roomList.AssetType(),
+		name:                    name,
+		description:             fmt.Sprintf("Room list %s (%v)", name, roomList.EmailAddress),
+	})
+	if err != nil {
+		return 0, err
+	}
+	parents[identifier] = id
+	return id, nil
+}
+
 type assetData struct {
 	config                  apiserver.Configuration
 	projectId               string
@@ -110,13 +300,32 @@ type assetData struct {
 }
 
 func upsertAsset(d assetData) (created bool, assetID int32, err error) {
-	// Get known asset id from configuration
-	currentAssetID, err := conf.GetAssetId(context.Background(), d.config, d.projectId, d.identifier)
+	// Get known asset from configuration
+	currentAsset, err := conf.FindAsset(context.Background(), d.config, d.projectId, d.identifier)
 	if err != nil {
 		return false, 0, fmt.Errorf("finding asset ID: %v", err)
 	}
-	if currentAssetID != nil {
-		return false, *currentAssetID, nil
+	// Assets mapped before switching to create mode stay untouched, a new asset is created.
+	if currentAsset != nil && currentAsset.Mapped {
+		if err := conf.DeleteAsset(context.Background(), currentAsset); err != nil {
+			return false, 0, fmt.Errorf("unlinking mapped asset: %v", err)
+		}
+		currentAsset = nil
+	}
+	if currentAsset != nil {
+		// The resource appeared again after it was removed from Microsoft 365.
+		if currentAsset.State != conf.AssetStateActive {
+			if err := setAssetState(currentAsset, conf.AssetStateActive, d.parentLocationalAssetId); err != nil {
+				return false, 0, fmt.Errorf("restoring asset: %v", err)
+			}
+			log.Info("eliona", "Restored asset %d of resource %s.", currentAsset.AssetID.Int32, d.identifier)
+		}
+		if hash := metadataHash(d); currentAsset.MetadataHash != hash {
+			if err := updateAssetMetadata(currentAsset, d, hash); err != nil {
+				return false, 0, fmt.Errorf("updating asset metadata: %v", err)
+			}
+		}
+		return false, currentAsset.AssetID.Int32, nil
 	}
 
 	a := api.Asset{
@@ -138,7 +347,7 @@ func upsertAsset(d assetData) (created bool, assetID int32, err error) {
 	}
 
 	// Remember the asset id for further usage
-	if err := conf.InsertAsset(context.Background(), d.config, d.projectId, d.identifier, *newID, d.email); err != nil {
+	if err := conf.InsertAsset(context.Background(), d.config, d.projectId, d.identifier, *newID, d.email, metadataHash(d)); err != nil {
 		return false, 0, fmt.Errorf("inserting asset to config db: %v", err)
 	}
 
@@ -146,3 +355,63 @@ func upsertAsset(d assetData) (created bool, assetID int32, err error) {
 
 	return true, *newID, nil
 }
+
+// metadataHash identifies the name, description and parents written to Eliona, so that they are
+// only written again if they changed in Microsoft 365.
+func metadataHash(d assetData) string {
+	var parent int32
+	if d.parentLocationalAssetId != nil {
+		parent = *d.parentLocationalAssetId
+	}
+	metadata := fmt.Appendf(nil, "%q %q %d", d.name, d.description, parent)
+	// Left out if not set, so that the hashes of assets without functional parent stay the same.
+	if d.parentFunctionalAssetId != nil {
+		metadata = fmt.Appendf(metadata, " %d", *d.parentFunctionalAssetId)
+	}
+	sum := sha256.Sum256(metadata)
+	return hex.EncodeToString(sum[:])
+}
+
+func updateAssetMetadata(dbAsset *appdb.Asset, d assetData, hash string) error {
+	found, err := updateElionaAsset(dbAsset.AssetID.Int32, func(a *api.Asset) {
+		a.Name = *api.NewNullableString(common.Ptr(d.name))
+		a.Description = *api.NewNullableString(common.Ptr(d.description))
+		if d.parentLocationalAssetId != nil {
+			a.ParentLocationalAssetId = *api.NewNullableInt32(d.parentLocationalAssetId)
+		}
+		// Rooms removed from all room lists lose their functional parent.
+		if d.parentFunctionalAssetId != nil || d.assetType == "microsoft_365_room" {
+			a.ParentFunctionalAssetId = *api.NewNullableInt32(d.parentFunctionalAssetId)
+		}
+	})
+	if err != nil {
+		return err
+	}
+	if !found {
+		return nil
+	}
+	log.Debug("eliona", "Updated name, description and parents of asset %d for project %s and device %s.", dbAsset.AssetID.Int32, d.projectId, d.identifier)
+	return conf.SetAssetMetadataHash(context.Background(), dbAsset, hash)
+}
+
+// updateElionaAsset reads the asset from Eliona, applies the changes and writes it back. It
+// returns false if the asset no longer exists in Eliona.
+func updateElionaAsset(assetID int32, update func(*api.Asset)) (bool, error) {
+	a, res, err := client.NewClient().AssetsAPI.
+		GetAssetById(client.AuthenticationContext(), assetID).
+		Execute()
+	if res != nil && res.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("getting asset %d from Eliona: %v", assetID, err)
+	}
+	update(a)
+	if _, _, err := client.NewClient().AssetsAPI.
+		PutAssetById(client.AuthenticationContext(), assetID).
+		Asset(*a).
+		Execute(); err != nil {
+		return false, fmt.Errorf("updating asset %d in Eliona: %v", assetID, err)
+	}
+	return true, nil
+}
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"microsoft-365/apiserver"
 	"microsoft-365/conf"
+	"reflect"
 
+	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
 	"github.com/eliona-smart-building-assistant/go-eliona/asset"
 	"github.com/eliona-smart-building-assistant/go-utils/log"
 )
@@ -24,17 +26,47 @@ func UpsertAssetData(config apiserver.Configuration, assets []Asset) error {
 				return err
 			}
 			if assetId == nil {
+				// Rooms and equipment without a matching asset are skipped in mapping mode.
+				if config.AssetMode == AssetModeMap {
+					continue
+				}
 				return fmt.Errorf("unable to find asset ID")
 			}
 
-			data := asset.Data{
-				AssetId: *assetId,
-				Data:    a,
-			}
-			if err := asset.UpsertAssetDataIfAssetExists(data); err != nil {
-				return fmt.Errorf("upserting data: %v", err)
+			assetType := a.AssetType()
+			for subtype, data := range splitBySubtype(a) {
+				if err := asset.UpsertDataIfAssetExists(api.Data{
+					AssetId:       *assetId,
+					Subtype:       subtype,
+					Data:          data,
+					AssetTypeName: *api.NewNullableString(&assetType),
+				}); err != nil {
+					return fmt.Errorf("upserting data for subtype %s: %v", subtype, err)
+				}
 			}
 		}
 	}
 	return nil
 }
+
+// splitBySubtype works like asset.SplitBySubtype, but also includes the attributes of embedded
+// structs such as the schedule shared by all resources with a calendar.
+func splitBySubtype(a any) map[api.DataSubtype]map[string]any {
+	result := asset.SplitBySubtype(a)
+	value := reflect.Indirect(reflect.ValueOf(a))
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if !field.Anonymous || !field.IsExported() || field.Type.Kind() != reflect.Struct {
+			continue
+		}
+		for subtype, data := range splitBySubtype(value.Field(i).Interface()) {
+			if result[subtype] == nil {
+				result[subtype] = make(map[string]any)
+			}
+			for name, v := range data {
+				result[subtype][name] = v
+			}
+		}
+	}
+	return result
+}
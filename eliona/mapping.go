@@ -0,0 +1,222 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package eliona
+
+import (
+	"context"
+	"fmt"
+	"microsoft-365/apiserver"
+	"microsoft-365/conf"
+	"microsoft-365/msgraph"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
+	"github.com/eliona-smart-building-assistant/go-eliona/asset"
+	"github.com/eliona-smart-building-assistant/go-eliona/client"
+	"github.com/eliona-smart-building-assistant/go-eliona/utils"
+	"github.com/eliona-smart-building-assistant/go-utils/common"
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+// Modes for the assets of a configuration.
+const (
+	// AssetModeCreate creates assets for the rooms and equipment.
+	AssetModeCreate = "create"
+	// AssetModeMap writes the data of the rooms and equipment to existing Eliona assets.
+	AssetModeMap = "map"
+)
+
+// mappableResource is a room or equipment to be mapped to an existing asset.
+type mappableResource struct {
+	identifier string
+	assetType  string
+	email      string
+	resource   any
+}
+
+// MapAssets links the rooms, equipment, workspaces and desks to existing Eliona assets, mapped explicitly or matched
+// by the mapping property of the configuration. Rooms and equipment without a matching asset are
+// skipped. If enabled in the configuration, the asset types of the matched assets get the
+// attributes of the app.
+func MapAssets(config apiserver.Configuration, rooms []msgraph.Room, equipmentList []msgraph.Equipment, workspaces []msgraph.Workspace, desks []msgraph.Desk) error {
+	var resources []mappableResource
+	for _, room := range rooms {
+		resources = append(resources, mappableResource{room.Id(), room.AssetType(), *room.EmailAddress, room})
+	}
+	for _, equipment := range equipmentList {
+		resources = append(resources, mappableResource{equipment.Id(), equipment.AssetType(), *equipment.EmailAddress, equipment})
+	}
+	for _, workspace := range workspaces {
+		resources = append(resources, mappableResource{workspace.Id(), workspace.AssetType(), *workspace.EmailAddress, workspace})
+	}
+	// Mappings are kept by email address, desks without a mailbox cannot be mapped.
+	for _, desk := range desks {
+		if desk.EmailAddress == nil || *desk.EmailAddress == "" {
+			continue
+		}
+		resources = append(resources, mappableResource{desk.Id(), desk.AssetType(), *desk.EmailAddress, desk})
+	}
+
+	for _, projectId := range conf.ProjIds(config) {
+		var assetsByGAI map[string]api.Asset
+		if config.MappingProperty != nil && *config.MappingProperty != "" {
+			var err error
+			if assetsByGAI, err = getProjectAssets(projectId); err != nil {
+				return fmt.Errorf("getting assets of project %s: %v", projectId, err)
+			}
+		}
+		for _, r := range resources {
+			if err := mapAsset(config, projectId, r, assetsByGAI); err != nil {
+				return fmt.Errorf("mapping %s: %v", r.identifier, err)
+			}
+		}
+	}
+	return nil
+}
+
+func mapAsset(config apiserver.Configuration, projectId string, r mappableResource, assetsByGAI map[string]api.Asset) error {
+	ctx := context.Background()
+	targetAssetID, err := conf.GetMappedAssetID(ctx, config, projectId, r.email)
+	if err != nil {
+		return fmt.Errorf("getting asset mapping: %v", err)
+	}
+	var targetAssetType string
+	if targetAssetID == nil && assetsByGAI != nil {
+		value, err := mappingValue(r.resource, *config.MappingProperty)
+		if err != nil {
+			return err
+		}
+		if a, ok := assetsByGAI[value]; ok && value != "" {
+			targetAssetID = a.Id.Get()
+			targetAssetType = a.AssetType
+		}
+	}
+
+	current, err := conf.FindAsset(ctx, config, projectId, r.identifier)
+	if err != nil {
+		return fmt.Errorf("finding asset: %v", err)
+	}
+	if targetAssetID == nil {
+		log.Debug("eliona", "No asset to map %s to in project %s.", r.identifier, projectId)
+		if current != nil && current.Mapped {
+			return conf.DeleteAsset(ctx, current)
+		}
+		return nil
+	}
+	if current != nil && current.Mapped && current.AssetID.Int32 == *targetAssetID && current.State == conf.AssetStateActive {
+		return nil
+	}
+
+	if targetAssetType == "" {
+		a, res, err := client.NewClient().AssetsAPI.
+			GetAssetById(client.AuthenticationContext(), *targetAssetID).
+			Execute()
+		if res != nil && res.StatusCode == http.StatusNotFound {
+			log.Warn("eliona", "Asset %d mapped to %s does not exist in Eliona.", *targetAssetID, r.identifier)
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("getting asset %d from Eliona: %v", *targetAssetID, err)
+		}
+		targetAssetType = a.AssetType
+	}
+	if config.ExtendAssetTypes != nil && *config.ExtendAssetTypes {
+		if err := addAttributes(targetAssetType, r.assetType); err != nil {
+			return fmt.Errorf("adding attributes to asset type %s: %v", targetAssetType, err)
+		}
+	} else if targetAssetType != r.assetType {
+		log.Debug("eliona", "Asset type %s of asset %d left unchanged, data of %s shows only for its own attributes.", targetAssetType, *targetAssetID, r.identifier)
+	}
+	if err := conf.UpsertMappedAsset(ctx, config, projectId, r.identifier, *targetAssetID, r.email); err != nil {
+		return err
+	}
+	log.Info("eliona", "Mapped %s to asset %d in project %s.", r.identifier, *targetAssetID, projectId)
+	return nil
+}
+
+// mappingValue returns the property of the room or equipment, named like in the asset filter.
+func mappingValue(resource any, property string) (string, error) {
+	properties, err := utils.StructToMap(resource)
+	if err != nil {
+		return "", fmt.Errorf("converting struct to map: %v", err)
+	}
+	return properties[property], nil
+}
+
+// projectAssetsCacheDuration defines how long the assets of a project are kept for matching,
+// instead of reading all of them again in every collection cycle.
+const projectAssetsCacheDuration = time.Hour
+
+type projectAssets struct {
+	fetchedAt   time.Time
+	assetsByGAI map[string]api.Asset
+}
+
+// projectAssetsCache keeps the assets of each project by project ID.
+var projectAssetsCache sync.Map
+
+// getProjectAssets returns the assets of the project by global asset identifier. The Eliona API
+// offers no lookup by global asset identifier, so all assets are read and kept for
+// projectAssetsCacheDuration.
+func getProjectAssets(projectId string) (map[string]api.Asset, error) {
+	if cached, ok := projectAssetsCache.Load(projectId); ok && time.Since(cached.(projectAssets).fetchedAt) < projectAssetsCacheDuration {
+		return cached.(projectAssets).assetsByGAI, nil
+	}
+	assets, _, err := client.NewClient().AssetsAPI.
+		GetAssets(client.AuthenticationContext()).
+		ProjectId(projectId).
+		Execute()
+	if err != nil {
+		return nil, err
+	}
+	assetsByGAI := make(map[string]api.Asset, len(assets))
+	for _, a := range assets {
+		assetsByGAI[a.GlobalAssetIdentifier] = a
+	}
+	projectAssetsCache.Store(projectId, projectAssets{fetchedAt: time.Now(), assetsByGAI: assetsByGAI})
+	return assetsByGAI, nil
+}
+
+// extendedAssetTypes remembers the asset types which already got the attributes of the app.
+var extendedAssetTypes sync.Map
+
+// addAttributes adds the attributes of the app's asset type to the asset type of a mapped asset,
+// so that the data written by the app shows up there.
+func addAttributes(targetAssetType string, assetType string) error {
+	if targetAssetType == assetType {
+		return nil
+	}
+	key := targetAssetType + " " + assetType
+	if _, ok := extendedAssetTypes.Load(key); ok {
+		return nil
+	}
+	path := fmt.Sprintf("eliona/asset-type-%s.json", strings.TrimPrefix(assetType, "microsoft_365_"))
+	appAssetType, err := common.UnmarshalFile[api.AssetType](path)
+	if err != nil {
+		return fmt.Errorf("unmarshalling file %s: %v", path, err)
+	}
+	for _, attribute := range appAssetType.Attributes {
+		attribute.AssetTypeName = *api.NewNullableString(common.Ptr(targetAssetType))
+		if err := asset.UpsertAssetTypeAttribute(attribute); err != nil {
+			return fmt.Errorf("upserting attribute %s: %v", attribute.Name, err)
+		}
+	}
+	extendedAssetTypes.Store(key, true)
+	return nil
+}
@@ -0,0 +1,188 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package eliona
+
+import (
+	"context"
+	"fmt"
+	"microsoft-365/apiserver"
+	"microsoft-365/conf"
+	"microsoft-365/msgraph"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/eliona-smart-building-assistant/go-eliona/client"
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+)
+
+// DefaultNoShowTimeout is the number of minutes after the start of a booking by which presence
+// must be seen in the room.
+const DefaultNoShowTimeout = 15
+
+// DetectNoShows flags the current meetings of rooms with a presence link as no-shows if no
+// presence was seen within the no-show timeout after their start. If configured, the room is
+// released by declining the meeting.
+func DetectNoShows(config apiserver.Configuration, graph *msgraph.GraphHelper, rooms []msgraph.Room) error {
+	links, err := conf.GetPresenceLinks(context.Background(), *config.Id)
+	if err != nil {
+		return fmt.Errorf("getting presence links: %v", err)
+	}
+	if len(links) == 0 {
+		return nil
+	}
+	// The evaluations are stored with the links, so that each meeting is evaluated and released
+	// only once, also across restarts and instances of the app.
+	evaluations, err := conf.GetNoShowEvaluations(context.Background(), *config.Id)
+	if err != nil {
+		return fmt.Errorf("getting no-show evaluations: %v", err)
+	}
+	linksByEmail := make(map[string]apiserver.PresenceLink, len(links))
+	for _, link := range links {
+		linksByEmail[link.Email] = link
+	}
+
+	timeout := time.Duration(config.NoShowTimeout) * time.Minute
+	now := time.Now()
+	for i := range rooms {
+		room := &rooms[i]
+		if room.EmailAddress == nil {
+			continue
+		}
+		email := strings.ToLower(*room.EmailAddress)
+		link, ok := linksByEmail[email]
+		if !ok {
+			continue
+		}
+		noShow := int8(0)
+		room.NoShow = &noShow
+		if room.CurrentMeetingStart == nil {
+			continue
+		}
+		start, err := time.Parse(time.RFC3339, *room.CurrentMeetingStart)
+		if err != nil {
+			log.Error("eliona", "Parsing start of current meeting of %s: %v", email, err)
+			continue
+		}
+		deadline := start.Add(timeout)
+		if now.Before(deadline) {
+			continue
+		}
+
+		if evaluation := evaluations[email]; evaluation.MeetingStart == *room.CurrentMeetingStart {
+			if evaluation.NoShow {
+				noShow = 1
+			}
+			continue
+		}
+		present, err := presenceSeen(link, start, deadline)
+		if err != nil {
+			// Without presence data there is no evidence of a no-show. Try again next time.
+			log.Warn("eliona", "Checking presence in room %s: %v", email, err)
+			continue
+		}
+		claimed, err := conf.ClaimNoShowEvaluation(context.Background(), *config.Id, email, conf.NoShowEvaluation{
+			MeetingStart: *room.CurrentMeetingStart,
+			NoShow:       !present,
+		})
+		if err != nil {
+			log.Error("eliona", "Storing no-show evaluation of room %s: %v", email, err)
+			continue
+		}
+		if present {
+			continue
+		}
+		noShow = 1
+		if !claimed {
+			// Another instance evaluated the meeting meanwhile and takes care of it.
+			continue
+		}
+		log.Info("eliona", "No presence in room %s within %v after start of meeting at %s.", email, timeout, *room.CurrentMeetingStart)
+
+		if config.NoShowRelease == nil || !*config.NoShowRelease {
+			continue
+		}
+		if err := graph.ReleaseRoom(config, *room.EmailAddress, start); err != nil {
+			log.Error("eliona", "Releasing room %s: %v", email, err)
+			// The meeting is evaluated and released again in the next run.
+			if err := conf.ClearNoShowEvaluation(context.Background(), *config.Id, email, *room.CurrentMeetingStart); err != nil {
+				log.Error("eliona", "Clearing no-show evaluation of room %s: %v", email, err)
+			}
+			continue
+		}
+		log.Info("eliona", "Released room %s.", email)
+	}
+	return nil
+}
+
+// presenceSeen tells whether the presence attribute of the linked asset was set at any time
+// between the start of the meeting and the deadline.
+func presenceSeen(link apiserver.PresenceLink, start, deadline time.Time) (bool, error) {
+	trend, _, err := client.NewClient().DataAPI.
+		GetDataTrendById(client.AuthenticationContext(), link.AssetId).
+		DataSubtype(link.Subtype).
+		AttributeName(link.Attribute).
+		FromDate(start.Format(time.RFC3339)).
+		ToDate(deadline.Format(time.RFC3339)).
+		Execute()
+	if err != nil {
+		return false, fmt.Errorf("getting trend of asset %d: %v", link.AssetId, err)
+	}
+	for _, data := range trend {
+		if isPresent(data.Data[link.Attribute]) {
+			return true, nil
+		}
+	}
+
+	// The trend contains only changes. A presence that started before the meeting and continued
+	// past the deadline shows only in the current value.
+	current, _, err := client.NewClient().DataAPI.
+		GetData(client.AuthenticationContext()).
+		AssetId(link.AssetId).
+		DataSubtype(link.Subtype).
+		Execute()
+	if err != nil {
+		return false, fmt.Errorf("getting data of asset %d: %v", link.AssetId, err)
+	}
+	for _, data := range current {
+		if !isPresent(data.Data[link.Attribute]) {
+			continue
+		}
+		if t, ok := data.GetTimestampOk(); !ok || t == nil || !t.After(deadline) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// isPresent interprets the value of a presence attribute. Any non-zero value means presence.
+func isPresent(value any) bool {
+	switch v := value.(type) {
+	case bool:
+		return v
+	case float64:
+		return v != 0
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			b, _ := strconv.ParseBool(v)
+			return b
+		}
+		return f != 0
+	default:
+		return false
+	}
+}
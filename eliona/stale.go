@@ -0,0 +1,175 @@
+//  This file is part of the eliona project.
+//  Copyright © 2022 LEICOM iTEC AG. All Rights Reserved.
+//  ______ _ _
+// |  ____| (_)
+// | |__  | |_  ___  _ __   __ _
+// |  __| | | |/ _ \| '_ \ / _` |
+// | |____| | | (_) | | | | (_| |
+// |______|_|_|\___/|_| |_|\__,_|
+//
+//  THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING
+//  BUT NOT LIMITED  TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+//  NON INFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+//  DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//  OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package eliona
+
+import (
+	"context"
+	"fmt"
+	"microsoft-365/apiserver"
+	"microsoft-365/appdb"
+	"microsoft-365/conf"
+	"microsoft-365/msgraph"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	api "github.com/eliona-smart-building-assistant/go-eliona-api-client/v2"
+	"github.com/eliona-smart-building-assistant/go-eliona/client"
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+	"github.com/volatiletech/null/v8"
+)
+
+// Policies for assets of rooms and equipment that are removed from Microsoft 365 or no longer
+// pass the asset filter.
+const (
+	StaleAssetPolicyInactive = "inactive"
+	StaleAssetPolicyArchive  = "archive"
+	StaleAssetPolicyDelete   = "delete"
+)
+
+// staleAssetDeleteDelay is how long a resource must be missing in all synchronizations before
+// its asset is deleted. Until then the asset is only deactivated, so a resource missing in a
+// single response of Microsoft 365 does not lose its asset and data.
+const staleAssetDeleteDelay = 24 * time.Hour
+
+// inactiveTag marks assets of resources that are no longer in Microsoft 365.
+const inactiveTag = "inactive"
+
+// HandleStaleAssets applies the stale asset policy of the configuration to all assets of rooms
+// and equipment that are missing in the collected assets. It must only be called after complete
+// synchronizations, as any resource not collected is considered removed.
+func HandleStaleAssets(config apiserver.Configuration, assets []Asset) error {
+	current := make(map[string]bool)
+	for _, a := range assets {
+		current[a.Id()] = true
+	}
+	dbAssets, err := conf.GetAssets(context.Background(), config)
+	if err != nil {
+		return fmt.Errorf("getting assets: %v", err)
+	}
+	for _, dbAsset := range dbAssets {
+		if !isResourceAsset(dbAsset.GlobalAssetID) {
+			continue
+		}
+		if current[dbAsset.GlobalAssetID] {
+			if dbAsset.MissingSince.Valid {
+				if err := conf.SetAssetMissingSince(context.Background(), dbAsset, null.Time{}); err != nil {
+					return fmt.Errorf("resetting missing since of asset %s: %v", dbAsset.GlobalAssetID, err)
+				}
+			}
+			continue
+		}
+		if !dbAsset.MissingSince.Valid {
+			if err := conf.SetAssetMissingSince(context.Background(), dbAsset, null.TimeFrom(time.Now())); err != nil {
+				return fmt.Errorf("setting missing since of asset %s: %v", dbAsset.GlobalAssetID, err)
+			}
+		}
+		// Mapped assets are not owned by the app, they are only unlinked.
+		if dbAsset.Mapped {
+			if err := conf.DeleteAsset(context.Background(), dbAsset); err != nil {
+				return fmt.Errorf("unlinking asset %s: %v", dbAsset.GlobalAssetID, err)
+			}
+			log.Info("eliona", "Unlinked asset %d of removed resource %s.", dbAsset.AssetID.Int32, dbAsset.GlobalAssetID)
+			continue
+		}
+		policy := config.StaleAssetPolicy
+		if policy == StaleAssetPolicyDelete && time.Since(dbAsset.MissingSince.Time) < staleAssetDeleteDelay {
+			policy = StaleAssetPolicyInactive
+		}
+		switch policy {
+		case StaleAssetPolicyDelete:
+			if err := deleteAsset(dbAsset); err != nil {
+				return fmt.Errorf("deleting asset %s: %v", dbAsset.GlobalAssetID, err)
+			}
+			log.Info("eliona", "Deleted asset %d of removed resource %s.", dbAsset.AssetID.Int32, dbAsset.GlobalAssetID)
+		case StaleAssetPolicyArchive:
+			if dbAsset.State == conf.AssetStateArchived {
+				continue
+			}
+			archiveAssetID, err := upsertArchiveAsset(config, dbAsset.ProjectID)
+			if err != nil {
+				return fmt.Errorf("upserting archive asset: %v", err)
+			}
+			if err := setAssetState(dbAsset, conf.AssetStateArchived, &archiveAssetID); err != nil {
+				return fmt.Errorf("archiving asset %s: %v", dbAsset.GlobalAssetID, err)
+			}
+			log.Info("eliona", "Archived asset %d of removed resource %s.", dbAsset.AssetID.Int32, dbAsset.GlobalAssetID)
+		default:
+			if dbAsset.State == conf.AssetStateInactive {
+				continue
+			}
+			if err := setAssetState(dbAsset, conf.AssetStateInactive, nil); err != nil {
+				return fmt.Errorf("deactivating asset %s: %v", dbAsset.GlobalAssetID, err)
+			}
+			log.Info("eliona", "Deactivated asset %d of removed resource %s.", dbAsset.AssetID.Int32, dbAsset.GlobalAssetID)
+		}
+	}
+	return nil
+}
+
+// isResourceAsset tells whether the asset belongs to a room, equipment, workspace or desk, as
+// opposed to the assets structuring them.
+func isResourceAsset(globalAssetID string) bool {
+	return strings.HasPrefix(globalAssetID, msgraph.Room{}.AssetType()+"_") ||
+		strings.HasPrefix(globalAssetID, msgraph.Equipment{}.AssetType()+"_") ||
+		strings.HasPrefix(globalAssetID, msgraph.Workspace{}.AssetType()+"_") ||
+		strings.HasPrefix(globalAssetID, msgraph.Desk{}.AssetType()+"_")
+}
+
+func upsertArchiveAsset(config apiserver.Configuration, projectId string) (int32, error) {
+	rootAssetID, err := upsertRootAsset(config, projectId)
+	if err != nil {
+		return 0, fmt.Errorf("upserting root asset: %v", err)
+	}
+	_, archiveAssetID, err := upsertAsset(assetData{
+		config:                  config,
+		projectId:               projectId,
+		parentLocationalAssetId: &rootAssetID,
+		identifier:              "microsoft_365_archive",
+		assetType:               "microsoft_365_root",
+		name:                    "Archived",
+		description:             "Assets of rooms and equipment removed from Microsoft 365",
+	})
+	return archiveAssetID, err
+}
+
+// setAssetState tags or untags the asset as inactive and moves it under the locational parent, if
+// one is given. Assets deleted in Eliona in the meantime only get the new state in the database.
+func setAssetState(dbAsset *appdb.Asset, state string, parentLocationalAssetId *int32) error {
+	if _, err := updateElionaAsset(dbAsset.AssetID.Int32, func(a *api.Asset) {
+		a.Tags = slices.DeleteFunc(a.Tags, func(tag string) bool { return tag == inactiveTag })
+		if state != conf.AssetStateActive {
+			a.Tags = append(a.Tags, inactiveTag)
+		}
+		if parentLocationalAssetId != nil {
+			a.ParentLocationalAssetId = *api.NewNullableInt32(parentLocationalAssetId)
+		}
+	}); err != nil {
+		return err
+	}
+	return conf.SetAssetState(context.Background(), dbAsset, state)
+}
+
+func deleteAsset(dbAsset *appdb.Asset) error {
+	res, err := client.NewClient().AssetsAPI.
+		DeleteAssetById(client.AuthenticationContext(), dbAsset.AssetID.Int32).
+		Execute()
+	if err != nil && (res == nil || res.StatusCode != http.StatusNotFound) {
+		return fmt.Errorf("deleting asset %d from Eliona: %v", dbAsset.AssetID.Int32, err)
+	}
+	return conf.DeleteAsset(context.Background(), dbAsset)
+}
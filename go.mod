@@ -4,12 +4,14 @@ go 1.24
 
 require (
 	github.com/Azure/azure-sdk-for-go/sdk/azcore v1.17.1
+	github.com/AzureAD/microsoft-authentication-library-for-go v1.4.2
 	github.com/eliona-smart-building-assistant/app-integration-tests v1.1.2
 	github.com/eliona-smart-building-assistant/go-eliona v1.10.5
 	github.com/eliona-smart-building-assistant/go-utils v1.1.4
 	github.com/gorilla/mux v1.8.1
 	github.com/microsoft/kiota-abstractions-go v1.9.1
 	github.com/microsoft/kiota-authentication-azure-go v1.2.1
+	github.com/microsoft/kiota-http-go v1.5.1
 	github.com/microsoftgraph/msgraph-sdk-go v1.67.0
 	github.com/microsoftgraph/msgraph-sdk-go-core v1.3.1
 	github.com/volatiletech/null/v8 v8.1.2
@@ -19,18 +21,17 @@ require (
 
 require (
 	github.com/Azure/azure-sdk-for-go/sdk/internal v1.10.0 // indirect
-	github.com/AzureAD/microsoft-authentication-library-for-go v1.4.2 // indirect
 	github.com/cjlapao/common-go v0.0.48 // indirect
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/ericlagergren/decimal v0.0.0-20240411145413-00de7ca16731 // indirect
 	github.com/go-logr/logr v1.4.2 // indirect
 	github.com/go-logr/stdr v1.2.2 // indirect
 	github.com/gofrs/uuid v4.4.0+incompatible // indirect
+	github.com/golang-jwt/jwt v3.2.2+incompatible // indirect
 	github.com/golang-jwt/jwt/v5 v5.2.2 // indirect
 	github.com/google/uuid v1.6.0 // indirect
 	github.com/gorilla/websocket v1.5.3 // indirect
 	github.com/kylelemons/godebug v1.1.0 // indirect
-	github.com/microsoft/kiota-http-go v1.5.1 // indirect
 	github.com/microsoft/kiota-serialization-form-go v1.1.1 // indirect
 	github.com/microsoft/kiota-serialization-json-go v1.1.1 // indirect
 	github.com/microsoft/kiota-serialization-multipart-go v1.1.1 // indirect
@@ -34,12 +34,17 @@ func assetTypes(t *testing.T) {
 	t.Parallel()
 
 	assert.AssetTypeExists(t, "microsoft_365_root", []string{})
-	assert.AssetTypeExists(t, "microsoft_365_room", []string{"email_address", "on_schedule"})
-	assert.AssetTypeExists(t, "microsoft_365_equipment", []string{"email_address", "on_schedule"})
+	assert.AssetTypeExists(t, "microsoft_365_room", []string{"email_address", "on_schedule", "is_busy_now", "next_free_at", "no_show", "utilization_day", "utilization_week"})
+	assert.AssetTypeExists(t, "microsoft_365_equipment", []string{"email_address", "on_schedule", "is_busy_now", "next_free_at", "utilization_day", "utilization_week"})
+	assert.AssetTypeExists(t, "microsoft_365_workspace", []string{"place_id", "email_address", "on_schedule", "is_busy_now"})
+	assert.AssetTypeExists(t, "microsoft_365_desk", []string{"place_id", "email_address", "mode", "on_schedule"})
+	assert.AssetTypeExists(t, "microsoft_365_room_list", []string{})
+	assert.AssetTypeExists(t, "microsoft_365_building", []string{})
+	assert.AssetTypeExists(t, "microsoft_365_floor", []string{})
 }
 
 func schema(t *testing.T) {
 	t.Parallel()
 
-	assert.SchemaExists(t, "microsoft_365", []string{"configuration", "asset"})
+	assert.SchemaExists(t, "microsoft_365", []string{"configuration", "asset", "delta_state", "directory_resource", "sync_status", "asset_mapping", "presence_link", "booking_session"})
 }
@@ -0,0 +1,284 @@
+package msgraph
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+	msgraphcore "github.com/microsoftgraph/msgraph-sdk-go-core"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/microsoftgraph/msgraph-sdk-go/users"
+)
+
+// maxBatchSize is the number of requests Graph accepts in one JSON batch.
+const maxBatchSize = 20
+
+// Limits for the number of batch requests sent in parallel.
+const (
+	DefaultBatchWorkers = 4
+	MaxBatchWorkers     = 16
+)
+
+// fetchUserPurposes reads the mailbox settings of the users in JSON batches, sent by the given
+// number of workers in parallel. Users without a mailbox are missing in the result. Users whose
+// mailbox settings cannot be read, e.g. because an application access policy denies it, are
+// returned with their error, so that they can be read again in the next run. An error is only
+// returned if a whole batch fails.
+func (g *GraphHelper) fetchUserPurposes(ctx context.Context, userIDs []string, workers int) (map[string]models.UserPurpose, map[string]error, error) {
+	if workers < 1 {
+		workers = DefaultBatchWorkers
+	}
+	batches := make(chan []string)
+	purposes := make(map[string]models.UserPurpose)
+	failed := make(map[string]error)
+	var mu sync.Mutex
+	var batchErr error
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for batch := range batches {
+				result, failedUsers, err := g.fetchUserPurposesBatch(ctx, batch)
+				mu.Lock()
+				if err != nil && batchErr == nil {
+					batchErr = err
+				}
+				for id, purpose := range result {
+					purposes[id] = purpose
+				}
+				for id, err := range failedUsers {
+					failed[id] = err
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for start := 0; start < len(userIDs); start += maxBatchSize {
+		end := min(start+maxBatchSize, len(userIDs))
+		batches <- userIDs[start:end]
+	}
+	close(batches)
+	wg.Wait()
+
+	if batchErr != nil {
+		return nil, nil, batchErr
+	}
+	return purposes, failed, nil
+}
+
+// fetchUserPurposesBatch reads the mailbox settings of up to maxBatchSize users. Requests
+// throttled within the batch are sent again in a new batch, as the batch itself succeeds.
+func (g *GraphHelper) fetchUserPurposesBatch(ctx context.Context, userIDs []string) (map[string]models.UserPurpose, map[string]error, error) {
+	purposes := make(map[string]models.UserPurpose)
+	failed := make(map[string]error)
+	pending := userIDs
+	for attempt := 0; ; attempt++ {
+		throttled, delay, err := g.sendUserPurposesBatch(ctx, pending, purposes, failed)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(throttled) == 0 {
+			return purposes, failed, nil
+		}
+		if attempt == maxRetries {
+			for _, id := range throttled {
+				failed[id] = fmt.Errorf("still throttled after %d retries", maxRetries)
+			}
+			return purposes, failed, nil
+		}
+		log.Debug("microsoft-365", "Mailbox settings of %d users throttled, retrying in %v.", len(throttled), delay)
+		if err := sleep(ctx, delay); err != nil {
+			return nil, nil, err
+		}
+		pending = throttled
+	}
+}
+
+// sendUserPurposesBatch adds the purposes of the users to purposes. It returns the users whose
+// requests were throttled along with the time to wait before sending them again. Users without a
+// mailbox have no purpose, other users whose mailbox settings cannot be read are added to failed.
+func (g *GraphHelper) sendUserPurposesBatch(ctx context.Context, userIDs []string, purposes map[string]models.UserPurpose, failed map[string]error) ([]string, time.Duration, error) {
+	batch := msgraphcore.NewBatchRequest(g.userClient.GetAdapter())
+	stepUsers := make(map[string]string)
+	for _, id := range userIDs {
+		requestInfo, err := g.userClient.Users().ByUserId(id).MailboxSettings().ToGetRequestInformation(ctx, nil)
+		if err != nil {
+			return nil, 0, fmt.Errorf("creating mailbox settings request for user %s: %v", id, err)
+		}
+		step, err := batch.AddBatchRequestStep(*requestInfo)
+		if err != nil {
+			return nil, 0, fmt.Errorf("adding mailbox settings request for user %s to batch: %v", id, err)
+		}
+		stepUsers[*step.GetId()] = id
+	}
+
+	response, err := batch.Send(ctx, g.userClient.GetAdapter())
+	if err != nil {
+		return nil, 0, fmt.Errorf("sending mailbox settings batch: %v", err)
+	}
+
+	var throttled []string
+	var delay time.Duration
+	for stepID, userID := range stepUsers {
+		item := response.GetResponseById(stepID)
+		if item == nil || item.GetStatus() == nil {
+			failed[userID] = fmt.Errorf("no response in batch")
+			continue
+		}
+		switch status := int(*item.GetStatus()); {
+		case status == http.StatusTooManyRequests || status == http.StatusServiceUnavailable:
+			throttled = append(throttled, userID)
+			delay = max(delay, retryAfterDelay(batchItemHeader(item, "Retry-After"), 0))
+			continue
+		case status == http.StatusNotFound || batchItemErrorCode(item) == "MailboxNotEnabledForRESTAPI":
+			// Users without a license have no mailbox and cannot be rooms or equipment.
+			log.Debug("microsoft-365", "User %s has no mailbox.", userID)
+			continue
+		}
+		settings, err := msgraphcore.GetBatchResponseById[models.MailboxSettingsable](
+			response, stepID, models.CreateMailboxSettingsFromDiscriminatorValue,
+		)
+		if err != nil {
+			failed[userID] = err
+			continue
+		}
+		if settings == nil || settings.GetUserPurpose() == nil {
+			continue
+		}
+		purposes[userID] = *settings.GetUserPurpose()
+	}
+	return throttled, delay, nil
+}
+
+func batchItemHeader(item msgraphcore.BatchItem, name string) string {
+	for key, value := range item.GetHeaders() {
+		if strings.EqualFold(key, name) {
+			return value
+		}
+	}
+	return ""
+}
+
+// batchItemErrorCode returns the code of the error in the body of a failed batch response.
+func batchItemErrorCode(item msgraphcore.BatchItem) string {
+	e, ok := item.GetBody()["error"].(map[string]any)
+	if !ok {
+		return ""
+	}
+	code, _ := e["code"].(string)
+	return code
+}
+
+// calendarViewRequest asks for the events in the calendar of a resource between from and to.
+type calendarViewRequest struct {
+	email string
+	from  time.Time
+	to    time.Time
+}
+
+// fetchCalendarViews reads the events of the calendar views in JSON batches, by email address,
+// following all pages. Requests throttled within a batch are sent again like in
+// fetchUserPurposesBatch. Calendars that cannot be read are returned with their error instead.
+func (g *GraphHelper) fetchCalendarViews(ctx context.Context, tz TimeZone, requests []calendarViewRequest, fields []string) (map[string][]models.Eventable, map[string]error) {
+	events := make(map[string][]models.Eventable)
+	failed := make(map[string]error)
+	for start := 0; start < len(requests); start += maxBatchSize {
+		pending := requests[start:min(start+maxBatchSize, len(requests))]
+		for attempt := 0; len(pending) > 0; attempt++ {
+			throttled, delay, err := g.sendCalendarViewBatch(ctx, tz, pending, fields, events, failed)
+			if err != nil {
+				for _, r := range pending {
+					failed[r.email] = err
+				}
+				break
+			}
+			if len(throttled) > 0 && attempt == maxRetries {
+				for _, r := range throttled {
+					failed[r.email] = fmt.Errorf("still throttled after %d retries", maxRetries)
+				}
+				break
+			}
+			if len(throttled) > 0 {
+				log.Debug("microsoft-365", "Calendar views of %d resources throttled, retrying in %v.", len(throttled), delay)
+				if err := sleep(ctx, delay); err != nil {
+					for _, r := range throttled {
+						failed[r.email] = err
+					}
+					break
+				}
+			}
+			pending = throttled
+		}
+	}
+	return events, failed
+}
+
+// sendCalendarViewBatch adds the events of the requested calendar views to events, or their error
+// to failed. It returns the requests that were throttled along with the time to wait before
+// sending them again.
+func (g *GraphHelper) sendCalendarViewBatch(ctx context.Context, tz TimeZone, requests []calendarViewRequest, fields []string, events map[string][]models.Eventable, failed map[string]error) ([]calendarViewRequest, time.Duration, error) {
+	batch := msgraphcore.NewBatchRequest(g.userClient.GetAdapter())
+	steps := make(map[string]calendarViewRequest)
+	for _, r := range requests {
+		requestInfo, err := g.userClient.Users().ByUserId(r.email).CalendarView().ToGetRequestInformation(ctx, calendarViewConfiguration(tz, r.from, r.to, fields))
+		if err != nil {
+			return nil, 0, fmt.Errorf("creating calendar view request for %s: %v", r.email, err)
+		}
+		step, err := batch.AddBatchRequestStep(*requestInfo)
+		if err != nil {
+			return nil, 0, fmt.Errorf("adding calendar view request for %s to batch: %v", r.email, err)
+		}
+		steps[*step.GetId()] = r
+	}
+
+	response, err := batch.Send(ctx, g.userClient.GetAdapter())
+	if err != nil {
+		return nil, 0, fmt.Errorf("sending calendar view batch: %v", err)
+	}
+
+	var throttled []calendarViewRequest
+	var delay time.Duration
+	for stepID, r := range steps {
+		item := response.GetResponseById(stepID)
+		if item == nil || item.GetStatus() == nil {
+			failed[r.email] = fmt.Errorf("no response in batch")
+			continue
+		}
+		if status := int(*item.GetStatus()); status == http.StatusTooManyRequests || status == http.StatusServiceUnavailable {
+			throttled = append(throttled, r)
+			delay = max(delay, retryAfterDelay(batchItemHeader(item, "Retry-After"), 0))
+			continue
+		}
+		page, err := msgraphcore.GetBatchResponseById[models.EventCollectionResponseable](
+			response, stepID, models.CreateEventCollectionResponseFromDiscriminatorValue,
+		)
+		if err != nil {
+			failed[r.email] = fmt.Errorf("querying calendar view of %s: %v", r.email, err)
+			continue
+		}
+		var calendar []models.Eventable
+		for {
+			calendar = append(calendar, page.GetValue()...)
+			if page.GetOdataNextLink() == nil || *page.GetOdataNextLink() == "" {
+				break
+			}
+			page, err = users.NewItemCalendarViewRequestBuilder(*page.GetOdataNextLink(), g.userClient.GetAdapter()).
+				Get(ctx, &users.ItemCalendarViewRequestBuilderGetRequestConfiguration{Headers: preferTimeZone(tz)})
+			if err != nil {
+				break
+			}
+		}
+		if err != nil {
+			failed[r.email] = fmt.Errorf("querying next page of calendar view of %s: %v", r.email, err)
+			continue
+		}
+		events[r.email] = calendar
+	}
+	return throttled, delay, nil
+}
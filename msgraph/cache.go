@@ -0,0 +1,57 @@
+package msgraph
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"microsoft-365/apiserver"
+	"sync"
+)
+
+// graphCache keeps the authenticated client of each configuration, so that the credentials and
+// their token caches are reused across refresh cycles and API requests.
+var graphCache = struct {
+	sync.Mutex
+	entries map[int64]cachedGraph
+}{entries: make(map[int64]cachedGraph)}
+
+type cachedGraph struct {
+	graph *GraphHelper
+	// credentialsHash detects configurations changed by other means than the API.
+	credentialsHash [sha256.Size]byte
+}
+
+func credentialsHash(config apiserver.Configuration) [sha256.Size]byte {
+	return sha256.Sum256(fmt.Appendf(nil, "%q %q %q %q %q %q %q",
+		config.ClientId, config.TenantId, *config.ClientSecret, *config.ClientCertificate,
+		*config.ClientCertificatePassword, *config.Username, *config.Password))
+}
+
+// GetGraph returns the authenticated client of the configuration, initializing it on first use.
+func GetGraph(config apiserver.Configuration) (*GraphHelper, error) {
+	if config.Id == nil || config.ClientSecret == nil || config.ClientCertificate == nil ||
+		config.ClientCertificatePassword == nil || config.Username == nil || config.Password == nil {
+		return nil, fmt.Errorf("shouldn't happen: some values are nil")
+	}
+	hash := credentialsHash(config)
+
+	graphCache.Lock()
+	defer graphCache.Unlock()
+	if cached, ok := graphCache.entries[*config.Id]; ok && cached.credentialsHash == hash {
+		return cached.graph, nil
+	}
+
+	graph := NewGraphHelper(*config.Id)
+	if err := graph.InitializeGraph(config.ClientId, config.TenantId, *config.ClientSecret,
+		*config.ClientCertificate, *config.ClientCertificatePassword, *config.Username, *config.Password); err != nil {
+		return nil, fmt.Errorf("initializing graph: %v", err)
+	}
+	graphCache.entries[*config.Id] = cachedGraph{graph: graph, credentialsHash: hash}
+	return graph, nil
+}
+
+// InvalidateGraph drops the cached client of the configuration, e.g. after its credentials changed.
+func InvalidateGraph(configID int64) {
+	graphCache.Lock()
+	defer graphCache.Unlock()
+	delete(graphCache.entries, configID)
+}
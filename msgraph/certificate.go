@@ -0,0 +1,53 @@
+package msgraph
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+)
+
+// parseCertificate reads a PEM encoded certificate or a base64 encoded PFX, each including the
+// private key.
+func parseCertificate(data, password string) ([]*x509.Certificate, crypto.PrivateKey, error) {
+	raw := []byte(data)
+	if !strings.Contains(data, "-----BEGIN") {
+		decoded, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(data), ""))
+		if err != nil {
+			return nil, nil, fmt.Errorf("certificate is neither PEM nor base64 encoded PFX: %v", err)
+		}
+		raw = decoded
+	}
+	var pw []byte
+	if password != "" {
+		pw = []byte(password)
+	}
+	certs, key, err := azidentity.ParseCertificates(raw, pw)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing certificate: %v", err)
+	}
+	return certs, key, nil
+}
+
+// ValidateCertificate checks that the certificate and its private key can be read and that the
+// certificate is currently valid.
+func ValidateCertificate(data, password string) error {
+	certs, _, err := parseCertificate(data, password)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, cert := range certs {
+		if now.Before(cert.NotBefore) {
+			return fmt.Errorf("certificate %q is not valid before %s", cert.Subject.CommonName, cert.NotBefore.Format(time.RFC3339))
+		}
+		if now.After(cert.NotAfter) {
+			return fmt.Errorf("certificate %q expired on %s", cert.Subject.CommonName, cert.NotAfter.Format(time.RFC3339))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,268 @@
+package msgraph
+
+import (
+	"context"
+	"fmt"
+	"microsoft-365/apiserver"
+	"microsoft-365/conf"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+	abstractions "github.com/microsoft/kiota-abstractions-go"
+	msgraphcore "github.com/microsoftgraph/msgraph-sdk-go-core"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/microsoftgraph/msgraph-sdk-go/users"
+)
+
+// Limits of the search for alternatives to a booking that conflicts with the schedule.
+const (
+	// alternativeSearchRange is how far before and after the requested time free slots are searched.
+	alternativeSearchRange = 4 * time.Hour
+	// alternativeStep is how much the requested time is shifted for each candidate slot.
+	alternativeStep = 15 * time.Minute
+	// maxAlternatives is the number of free slots offered, per resource and for other rooms.
+	maxAlternatives = 3
+	// maxSchedules is the number of schedules getSchedule accepts in one request.
+	maxSchedules = 20
+)
+
+// FindBookingConflict checks whether the resource is free between startDT and endDT. It returns
+// nil if it is, otherwise the busy periods in that time along with the nearest free slots of the
+// same length in the resource, and other rooms of the same building with at least the same
+// capacity that are free at the requested time.
+func (g *GraphHelper) FindBookingConflict(ctx context.Context, config apiserver.Configuration, tz TimeZone, startDT, endDT, resourceEmail string) (*apiserver.BookingConflict, error) {
+	start, err := tz.parseInput(startDT)
+	if err != nil {
+		return nil, fmt.Errorf("parsing start: %v", err)
+	}
+	end, err := tz.parseInput(endDT)
+	if err != nil {
+		return nil, fmt.Errorf("parsing end: %v", err)
+	}
+	if !end.After(start) {
+		// Creating the event fails with a proper error.
+		return nil, nil
+	}
+
+	searchFrom := maxTime(start.Add(-alternativeSearchRange), time.Now())
+	searchTo := end.Add(alternativeSearchRange)
+	schedules, err := g.fetchBusyPeriods(ctx, tz, []string{resourceEmail}, minTime(searchFrom, start), searchTo)
+	if err != nil {
+		return nil, fmt.Errorf("fetching schedule of %s: %v", resourceEmail, err)
+	}
+	periods, ok := schedules[strings.ToLower(resourceEmail)]
+	if !ok {
+		// Without the schedule, the booking could silently be declined.
+		return nil, fmt.Errorf("schedule of %s could not be read", resourceEmail)
+	}
+	conflicting := overlappingPeriods(periods, start, end)
+	if len(conflicting) == 0 {
+		return nil, nil
+	}
+
+	conflict := &apiserver.BookingConflict{}
+	for _, p := range conflicting {
+		conflict.Conflicts = append(conflict.Conflicts, apiserver.TimeSlot{Start: p.start, End: p.end})
+	}
+	duration := end.Sub(start)
+	for _, slot := range nearestFreeSlots(periods, start, duration, searchFrom, searchTo) {
+		conflict.Alternatives = append(conflict.Alternatives, apiserver.AlternativeSlot{
+			Email: resourceEmail,
+			Start: slot,
+			End:   slot.Add(duration),
+		})
+	}
+	rooms, err := g.alternativeRooms(ctx, config, tz, resourceEmail, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("finding alternative rooms: %v", err)
+	}
+	conflict.Alternatives = append(conflict.Alternatives, rooms...)
+	return conflict, nil
+}
+
+// alternativeRooms returns rooms in the building of the booked room that have at least its
+// capacity and are free between start and end. Rooms with the closest capacity come first.
+func (g *GraphHelper) alternativeRooms(ctx context.Context, config apiserver.Configuration, tz TimeZone, resourceEmail string, start, end time.Time) ([]apiserver.AlternativeSlot, error) {
+	resources, err := conf.GetDirectoryResources(ctx, config, purposeRoom)
+	if err != nil {
+		return nil, fmt.Errorf("getting rooms: %v", err)
+	}
+	var booked *Room
+	var rooms []Room
+	for _, resource := range resources {
+		room, err := roomFromResource(resource)
+		if err != nil {
+			return nil, err
+		}
+		if strings.EqualFold(*room.EmailAddress, resourceEmail) {
+			booked = &room
+			continue
+		}
+		adheres, err := room.AdheresToFilter(config)
+		if err != nil {
+			return nil, fmt.Errorf("checking if room adheres to a filter: %v", err)
+		}
+		if adheres {
+			rooms = append(rooms, room)
+		}
+	}
+	if booked == nil || booked.Building == nil || *booked.Building == "" {
+		// Equipment, or a room that cannot be compared to others.
+		return nil, nil
+	}
+
+	var candidates []Room
+	for _, room := range rooms {
+		if room.Building == nil || *room.Building != *booked.Building {
+			continue
+		}
+		if booked.Capacity != nil && (room.Capacity == nil || *room.Capacity < *booked.Capacity) {
+			continue
+		}
+		candidates = append(candidates, room)
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return capacity(candidates[i]) < capacity(candidates[j])
+	})
+	if len(candidates) > maxSchedules {
+		candidates = candidates[:maxSchedules]
+	}
+
+	var emails []string
+	for _, room := range candidates {
+		emails = append(emails, *room.EmailAddress)
+	}
+	schedules, err := g.fetchBusyPeriods(ctx, tz, emails, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("fetching schedules: %v", err)
+	}
+	var alternatives []apiserver.AlternativeSlot
+	for _, room := range candidates {
+		periods, ok := schedules[strings.ToLower(*room.EmailAddress)]
+		if !ok || len(overlappingPeriods(periods, start, end)) > 0 {
+			continue
+		}
+		alternative := apiserver.AlternativeSlot{
+			Email: *room.EmailAddress,
+			Start: start,
+			End:   end,
+		}
+		if room.DisplayName != nil {
+			alternative.DisplayName = *room.DisplayName
+		}
+		alternatives = append(alternatives, alternative)
+		if len(alternatives) == maxAlternatives {
+			break
+		}
+	}
+	return alternatives, nil
+}
+
+func capacity(room Room) int32 {
+	if room.Capacity == nil {
+		return 0
+	}
+	return *room.Capacity
+}
+
+// fetchBusyPeriods returns the busy periods of the resources between from and to, by lower-cased
+// email address. Resources whose schedule could not be read are left out, callers decide whether
+// that is an error.
+func (g *GraphHelper) fetchBusyPeriods(ctx context.Context, tz TimeZone, emails []string, from, to time.Time) (map[string][]busyPeriod, error) {
+	headers := abstractions.NewRequestHeaders()
+	headers.Add("Prefer", tz.preferHeader())
+	configuration := &users.ItemCalendarGetScheduleRequestBuilderPostRequestConfiguration{
+		Headers: headers,
+	}
+	requestBody := users.NewItemCalendarGetSchedulePostRequestBody()
+	requestBody.SetSchedules(emails)
+	startTime := models.NewDateTimeTimeZone()
+	ts1 := tz.format(from)
+	startTime.SetDateTime(&ts1)
+	startTime.SetTimeZone(&tz.Windows)
+	requestBody.SetStartTime(startTime)
+	endTime := models.NewDateTimeTimeZone()
+	ts2 := tz.format(to)
+	endTime.SetDateTime(&ts2)
+	endTime.SetTimeZone(&tz.Windows)
+	requestBody.SetEndTime(endTime)
+	interval := int32(alternativeStep / time.Minute)
+	requestBody.SetAvailabilityViewInterval(&interval)
+
+	r, err := g.postGetSchedule(ctx, requestBody, configuration, emails[0])
+	if err != nil {
+		return nil, err
+	}
+	pageIterator, err := msgraphcore.NewPageIterator[*models.ScheduleInformation](
+		r, g.userClient.GetAdapter(), models.CreateScheduleInformationFromDiscriminatorValue,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("getting schedule iterator: %v", err)
+	}
+	schedules := make(map[string][]busyPeriod)
+	if err := pageIterator.Iterate(ctx, func(schedule *models.ScheduleInformation) bool {
+		if schedule == nil {
+			return false
+		}
+		if schedule.GetScheduleId() == nil {
+			return true
+		}
+		if e := schedule.GetError(); e != nil {
+			var message string
+			if e.GetMessage() != nil {
+				message = *e.GetMessage()
+			}
+			log.Debug("microsoft-365", "Schedule of %s could not be read: %s", *schedule.GetScheduleId(), message)
+			return true
+		}
+		periods, err := busyPeriods(tz, schedule.GetScheduleItems())
+		if err != nil {
+			log.Debug("microsoft-365", "Reading schedule of %s: %v", *schedule.GetScheduleId(), err)
+			return true
+		}
+		schedules[strings.ToLower(*schedule.GetScheduleId())] = periods
+		// Return true to continue the iteration.
+		return true
+	}); err != nil {
+		return nil, fmt.Errorf("iterating schedules: %v", err)
+	}
+	return schedules, nil
+}
+
+// overlappingPeriods returns the periods overlapping the time between start and end.
+func overlappingPeriods(periods []busyPeriod, start, end time.Time) []busyPeriod {
+	var overlapping []busyPeriod
+	for _, p := range periods {
+		if p.start.Before(end) && p.end.After(start) {
+			overlapping = append(overlapping, p)
+		}
+	}
+	return overlapping
+}
+
+// nearestFreeSlots returns the starts of up to maxAlternatives free slots of the given duration
+// between from and to. The slots are found by shifting the requested start in steps, so the
+// nearest ones come first.
+func nearestFreeSlots(periods []busyPeriod, start time.Time, duration time.Duration, from, to time.Time) []time.Time {
+	var slots []time.Time
+	for offset := alternativeStep; offset <= alternativeSearchRange; offset += alternativeStep {
+		for _, slot := range []time.Time{start.Add(-offset), start.Add(offset)} {
+			if slot.Before(from) || slot.Add(duration).After(to) {
+				continue
+			}
+			if len(overlappingPeriods(periods, slot, slot.Add(duration))) > 0 {
+				continue
+			}
+			slots = append(slots, slot)
+			if len(slots) == maxAlternatives {
+				return slots
+			}
+		}
+	}
+	return slots
+}
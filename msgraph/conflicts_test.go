@@ -0,0 +1,42 @@
+package msgraph
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNearestFreeSlots(t *testing.T) {
+	tz := testTimeZone(t)
+	periods := []busyPeriod{period(tz, 9, 0, 11, 30)}
+	slots := nearestFreeSlots(periods, at(tz, 10, 0), time.Hour, at(tz, 8, 0), at(tz, 18, 0))
+	// Slots before and after the requested time alternate, the nearest first. A slot may end
+	// when a busy period starts.
+	expectSlots(t, slots, []time.Time{at(tz, 11, 30), at(tz, 11, 45), at(tz, 8, 0)})
+}
+
+func TestNearestFreeSlotsWithinBounds(t *testing.T) {
+	tz := testTimeZone(t)
+	periods := []busyPeriod{period(tz, 10, 0, 11, 0)}
+	slots := nearestFreeSlots(periods, at(tz, 10, 30), 30*time.Minute, at(tz, 9, 30), at(tz, 11, 30))
+	expectSlots(t, slots, []time.Time{at(tz, 11, 0), at(tz, 9, 30)})
+}
+
+func TestNearestFreeSlotsNone(t *testing.T) {
+	tz := testTimeZone(t)
+	periods := []busyPeriod{period(tz, 0, 0, 23, 0)}
+	if slots := nearestFreeSlots(periods, at(tz, 12, 0), time.Hour, at(tz, 0, 0), at(tz, 23, 0)); len(slots) != 0 {
+		t.Errorf("got slots %v, want none", slots)
+	}
+}
+
+func expectSlots(t *testing.T, got, want []time.Time) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got slots %v, want %v", got, want)
+	}
+	for i := range got {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("slot %d is %v, want %v", i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,403 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"microsoft-365/apiserver"
+	"microsoft-365/appdb"
+	"microsoft-365/conf"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+	abstractions "github.com/microsoft/kiota-abstractions-go"
+	msgraphcore "github.com/microsoftgraph/msgraph-sdk-go-core"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/microsoftgraph/msgraph-sdk-go/users"
+	"github.com/volatiletech/null/v8"
+)
+
+// usersDeltaResource identifies the delta query over the users of the tenant. Room and equipment
+// mailboxes are users whose mailbox settings tell their purpose.
+const usersDeltaResource = "users"
+
+// roomsRefreshInterval defines how often the details of all rooms are read again. The places API
+// offers no delta queries, and changes of e.g. the capacity do not show up in the users delta.
+const roomsRefreshInterval = 24 * time.Hour
+
+const (
+	purposeRoom      = "room"
+	purposeEquipment = "equipment"
+)
+
+// ErrIncompleteSync is returned by SyncDirectory if only some of the resources were synchronized.
+// The stored resources are still valid, but resources missing in them are not necessarily removed.
+var ErrIncompleteSync = errors.New("directory synchronization incomplete")
+
+// SyncDirectory updates the rooms, equipment, workspaces and desks stored for the configuration.
+// The first run reads all users of the tenant, following runs process only users changed since the
+// previous run.
+func (g *GraphHelper) SyncDirectory(config apiserver.Configuration) error {
+	ctx := context.Background()
+	state, err := conf.GetDeltaState(ctx, config, usersDeltaResource)
+	if err != nil {
+		return fmt.Errorf("getting delta state: %v", err)
+	}
+	if state == nil {
+		state = &appdb.DeltaState{Resource: usersDeltaResource}
+	}
+
+	fullSync := state.DeltaLink == ""
+	deltaLink, failedUserIDs, err := g.syncUsers(ctx, config, state.DeltaLink, state.FailedUserIds)
+	if errors.Is(err, errDeltaLinkExpired) {
+		log.Info("microsoft-365", "Delta link of configuration %d expired, reading all users again.", *config.Id)
+		fullSync = true
+		deltaLink, failedUserIDs, err = g.syncUsers(ctx, config, "", state.FailedUserIds)
+	}
+	if err != nil {
+		return fmt.Errorf("synchronizing users: %v", err)
+	}
+	refreshRoomLists := fullSync
+	if fullSync {
+		// The details of all rooms were read along with the users.
+		state.RoomsRefreshedAt = time.Now()
+	}
+	state.DeltaLink = deltaLink
+	state.FailedUserIds = failedUserIDs
+
+	if time.Since(state.RoomsRefreshedAt) > roomsRefreshInterval {
+		if err := g.refreshRooms(ctx, config); err != nil {
+			return fmt.Errorf("refreshing rooms: %v", err)
+		}
+		state.RoomsRefreshedAt = time.Now()
+		refreshRoomLists = true
+	}
+
+	// Like the room details, the room lists offer no delta queries.
+	if refreshRoomLists {
+		if err := g.syncRoomLists(ctx, config); err != nil {
+			return fmt.Errorf("synchronizing room lists: %v", err)
+		}
+	}
+
+	if err := conf.UpsertDeltaState(ctx, config, *state); err != nil {
+		return fmt.Errorf("storing delta state: %v", err)
+	}
+
+	// Tenants without Microsoft Places still get their rooms and equipment.
+	if err := g.syncPlaces(ctx, config); err != nil {
+		return fmt.Errorf("%w: synchronizing workspaces and desks: %v", ErrIncompleteSync, err)
+	}
+	return nil
+}
+
+// IsKnownRoom tells whether the email address belongs to a room found by SyncDirectory.
+func IsKnownRoom(ctx context.Context, config apiserver.Configuration, email string) (bool, error) {
+	rooms, err := conf.GetDirectoryResources(ctx, config, purposeRoom)
+	if err != nil {
+		return false, fmt.Errorf("getting rooms: %v", err)
+	}
+	for _, room := range rooms {
+		if strings.EqualFold(room.Email, email) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// errDeltaLinkExpired is returned if Graph refuses a delta link because it is too old.
+var errDeltaLinkExpired = errors.New("delta link expired")
+
+// syncUsers processes the users returned by the delta query and returns the delta link for the
+// next run. An empty delta link starts a new query returning all users. The users whose mailbox
+// settings could not be read are returned as well and must be passed as failedUserIDs in the next
+// run, as the delta query will not return them again unless they change.
+func (g *GraphHelper) syncUsers(ctx context.Context, config apiserver.Configuration, deltaLink string, failedUserIDs []string) (string, []string, error) {
+	var r users.DeltaGetResponseable
+	var err error
+	if deltaLink == "" {
+		r, err = g.userClient.Users().Delta().GetAsDeltaGetResponse(ctx, &users.DeltaRequestBuilderGetRequestConfiguration{
+			QueryParameters: &users.DeltaRequestBuilderGetQueryParameters{
+				Select: []string{"id", "displayName", "mail", "userPrincipalName"},
+			},
+		})
+	} else {
+		r, err = users.NewDeltaRequestBuilder(deltaLink, g.userClient.GetAdapter()).GetAsDeltaGetResponse(ctx, nil)
+	}
+	var apiErr abstractions.ApiErrorable
+	if errors.As(err, &apiErr) && apiErr.GetStatusCode() == http.StatusGone {
+		return "", nil, errDeltaLinkExpired
+	}
+	if err != nil {
+		return "", nil, fmt.Errorf("querying users delta API: %v", err)
+	}
+
+	pageIterator, err := msgraphcore.NewPageIterator[*models.User](
+		r, g.userClient.GetAdapter(), users.CreateDeltaGetResponseFromDiscriminatorValue,
+	)
+	if err != nil {
+		return "", nil, fmt.Errorf("getting users delta iterator: %v", err)
+	}
+
+	stored, err := conf.GetDirectoryResources(ctx, config, "")
+	if err != nil {
+		return "", nil, fmt.Errorf("getting directory resources: %v", err)
+	}
+	resources := make(map[string]*appdb.DirectoryResource)
+	for _, resource := range stored {
+		resources[resource.UserID] = resource
+	}
+
+	// Users may show up more than once in the delta, the last occurrence is the current state.
+	changed := make(map[string]*models.User)
+	if err := pageIterator.Iterate(ctx, func(msuser *models.User) bool {
+		if msuser != nil && msuser.GetId() != nil {
+			changed[*msuser.GetId()] = msuser
+		}
+		// Return true to continue the iteration
+		return true
+	}); err != nil {
+		return "", nil, fmt.Errorf("iterating users delta: %v", err)
+	}
+
+	// The purpose cannot be selected or filtered for in the delta query, mailboxSettings can be
+	// accessed only user by user. See
+	// https://feedbackportal.microsoft.com/feedback/idea/65df37d3-8f21-ee11-a81c-002248510ddf
+	// for more info.
+	var userIDs []string
+	for id, msuser := range changed {
+		if !isRemoved(msuser) {
+			userIDs = append(userIDs, id)
+		}
+	}
+	// Users that failed before are read again even if they did not change.
+	var retried []string
+	for _, id := range failedUserIDs {
+		if _, ok := changed[id]; !ok {
+			userIDs = append(userIDs, id)
+			retried = append(retried, id)
+		}
+	}
+	purposes, failed, err := g.fetchUserPurposes(ctx, userIDs, int(config.BatchWorkers))
+	if err != nil {
+		return "", nil, fmt.Errorf("fetching mailbox settings: %v", err)
+	}
+
+	for _, id := range retried {
+		purpose, ok := purposes[id]
+		if !ok {
+			continue
+		}
+		msuser := models.NewUser()
+		msuser.SetId(&id)
+		// Only the ID is known of users not in the delta, rooms and equipment need all properties.
+		if purpose == models.ROOM_USERPURPOSE || purpose == models.EQUIPMENT_USERPURPOSE {
+			u, err := g.userClient.Users().ByUserId(id).Get(ctx, &users.UserItemRequestBuilderGetRequestConfiguration{
+				QueryParameters: &users.UserItemRequestBuilderGetQueryParameters{
+					Select: []string{"id", "displayName", "mail", "userPrincipalName"},
+				},
+			})
+			if err != nil {
+				failed[id] = fmt.Errorf("querying users API: %v", err)
+				continue
+			}
+			msuser, ok = u.(*models.User)
+			if !ok {
+				return "", nil, fmt.Errorf("shouldn't happen: unexpected user type %T", u)
+			}
+		}
+		changed[id] = msuser
+	}
+
+	for _, msuser := range changed {
+		if err := g.syncUser(ctx, config, msuser, purposes, resources); err != nil {
+			return "", nil, err
+		}
+	}
+
+	link := pageIterator.GetOdataDeltaLink()
+	if link == nil {
+		return "", nil, fmt.Errorf("users delta returned no delta link")
+	}
+	var failedIDs []string
+	for id, err := range failed {
+		// Known failures, e.g. mailboxes excluded by an application access policy, are retried
+		// quietly.
+		if slices.Contains(failedUserIDs, id) {
+			log.Debug("microsoft-365", "Mailbox settings of user %s still cannot be read: %v", id, err)
+		} else {
+			log.Warn("microsoft-365", "Mailbox settings of user %s cannot be read, retrying in the next run: %v", id, err)
+		}
+		failedIDs = append(failedIDs, id)
+	}
+	return *link, failedIDs, nil
+}
+
+func isRemoved(msuser *models.User) bool {
+	_, removed := msuser.GetAdditionalData()["@removed"]
+	return removed
+}
+
+// syncUser stores or removes a changed user. Only database errors are returned, users without a
+// mailbox are kept as they are.
+func (g *GraphHelper) syncUser(ctx context.Context, config apiserver.Configuration, msuser *models.User, purposes map[string]models.UserPurpose, resources map[string]*appdb.DirectoryResource) error {
+	id := *msuser.GetId()
+	stored, known := resources[id]
+	if isRemoved(msuser) {
+		if !known {
+			return nil
+		}
+		log.Debug("microsoft-365", "Resource %s removed from the directory.", stored.Email)
+		delete(resources, id)
+		return conf.DeleteDirectoryResource(ctx, config, id)
+	}
+
+	// Changed users contain only the changed properties.
+	resource := &appdb.DirectoryResource{UserID: id}
+	if known {
+		*resource = *stored
+	}
+	if name := msuser.GetDisplayName(); name != nil {
+		resource.DisplayName = *name
+	}
+
+	purpose, ok := purposes[id]
+	if !ok {
+		return nil
+	}
+	if purpose != models.ROOM_USERPURPOSE && purpose != models.EQUIPMENT_USERPURPOSE {
+		if !known {
+			return nil
+		}
+		delete(resources, id)
+		return conf.DeleteDirectoryResource(ctx, config, id)
+	}
+	resource.Purpose = purpose.String()
+
+	// Rooms are identified by their primary SMTP address, as in Microsoft Places, which may differ
+	// from the user principal name. The latter is only used for rooms without a mail address and
+	// for equipment.
+	email := msuser.GetUserPrincipalName()
+	if purpose == models.ROOM_USERPURPOSE {
+		email = msuser.GetMail()
+		if (email == nil || *email == "") && resource.Email == "" {
+			email = msuser.GetUserPrincipalName()
+		}
+	}
+	if email != nil && *email != "" {
+		resource.Email = *email
+	}
+	if resource.Email == "" {
+		return nil
+	}
+
+	if resource.Purpose == purposeRoom {
+		room, err := g.userClient.Places().ByPlaceId(resource.Email).GraphRoom().Get(ctx, nil)
+		if err != nil {
+			log.Error("microsoft-365", "querying places API for room %s: %v", resource.Email, err)
+		} else if err := setRoomDetails(resource, room); err != nil {
+			return err
+		}
+	} else {
+		resource.Room = null.JSON{}
+	}
+
+	if err := conf.UpsertDirectoryResource(ctx, config, resource); err != nil {
+		return err
+	}
+	resources[id] = resource
+	return nil
+}
+
+// refreshRooms reads the details of all rooms again and updates the stored rooms.
+func (g *GraphHelper) refreshRooms(ctx context.Context, config apiserver.Configuration) error {
+	r, err := g.userClient.Places().GraphRoom().Get(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("querying rooms API: %+v", err)
+	}
+
+	pageIterator, err := msgraphcore.NewPageIterator[*models.Room](
+		r, g.userClient.GetAdapter(), models.CreateRoomCollectionResponseFromDiscriminatorValue,
+	)
+	if err != nil {
+		return fmt.Errorf("getting room iterator: %v", err)
+	}
+
+	details := make(map[string]models.Roomable)
+	if err := pageIterator.Iterate(ctx, func(msroom *models.Room) bool {
+		if msroom != nil && msroom.GetEmailAddress() != nil {
+			details[strings.ToLower(*msroom.GetEmailAddress())] = msroom
+		}
+		// Return true to continue the iteration
+		return true
+	}); err != nil {
+		return fmt.Errorf("iterating rooms: %v", err)
+	}
+
+	rooms, err := conf.GetDirectoryResources(ctx, config, purposeRoom)
+	if err != nil {
+		return fmt.Errorf("getting rooms: %v", err)
+	}
+	for _, resource := range rooms {
+		room, ok := details[strings.ToLower(resource.Email)]
+		if !ok {
+			continue
+		}
+		if err := setRoomDetails(resource, room); err != nil {
+			return err
+		}
+		if err := conf.UpsertDirectoryResource(ctx, config, resource); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func setRoomDetails(resource *appdb.DirectoryResource, r models.Roomable) error {
+	msroom, ok := r.(*models.Room)
+	if !ok {
+		return fmt.Errorf("shouldn't happen: unexpected room type %T", r)
+	}
+	details := convertToRoom(*msroom)
+	// The room lists are not part of the room details, they are kept until syncRoomLists runs.
+	stored, err := roomFromResource(resource)
+	if err != nil {
+		return err
+	}
+	details.RoomLists = stored.RoomLists
+	room, err := json.Marshal(details)
+	if err != nil {
+		return fmt.Errorf("marshalling room %s: %v", resource.Email, err)
+	}
+	resource.Room = null.JSONFrom(room)
+	return nil
+}
+
+// roomFromResource returns the stored room. Rooms whose details could not be read yet carry only
+// the name and email address.
+func roomFromResource(resource *appdb.DirectoryResource) (Room, error) {
+	var room Room
+	if resource.Room.Valid {
+		if err := json.Unmarshal(resource.Room.JSON, &room); err != nil {
+			return Room{}, fmt.Errorf("unmarshalling room %s: %v", resource.Email, err)
+		}
+	}
+	if room.EmailAddress == nil {
+		room.EmailAddress = &resource.Email
+	}
+	if room.DisplayName == nil {
+		room.DisplayName = &resource.DisplayName
+	}
+	return room, nil
+}
+
+func equipmentFromResource(resource *appdb.DirectoryResource) Equipment {
+	return Equipment{
+		DisplayName:  &resource.DisplayName,
+		EmailAddress: &resource.Email,
+	}
+}
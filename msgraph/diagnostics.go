@@ -0,0 +1,147 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"microsoft-365/apiserver"
+	"microsoft-365/conf"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/eliona-smart-building-assistant/go-utils/common"
+	msgraphcore "github.com/microsoftgraph/msgraph-sdk-go-core"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+)
+
+// requiredPermissions lists the Graph permissions the app needs, each with the permissions that
+// include it.
+var requiredPermissions = []struct {
+	name       string
+	includedIn []string
+}{
+	{"Place.Read.All", []string{"Place.ReadWrite.All"}},
+	{"Calendars.Read", []string{"Calendars.ReadWrite", "Calendars.Read.Shared", "Calendars.ReadWrite.Shared"}},
+	{"MailboxSettings.Read", []string{"MailboxSettings.ReadWrite"}},
+	{"User.Read.All", []string{"User.ReadWrite.All", "Directory.Read.All", "Directory.ReadWrite.All"}},
+}
+
+// tokenClaims are the claims of a Graph access token telling the granted permissions.
+type tokenClaims struct {
+	// Application permissions
+	Roles []string `json:"roles"`
+	// Delegated permissions, separated by spaces
+	Scp string `json:"scp"`
+}
+
+// parseTokenClaims reads the claims of the JWT without verifying it. The token comes directly
+// from Entra, it is only inspected for diagnostics.
+func parseTokenClaims(token string) (tokenClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return tokenClaims{}, fmt.Errorf("token is not a JWT")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return tokenClaims{}, fmt.Errorf("decoding token payload: %v", err)
+	}
+	var claims tokenClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return tokenClaims{}, fmt.Errorf("unmarshalling token claims: %v", err)
+	}
+	return claims, nil
+}
+
+// Diagnose checks whether the configuration works: it acquires a token, checks the granted
+// permissions and counts the visible rooms as well as the synchronized rooms and equipment. Failed
+// checks are part of the report, not errors.
+func (g *GraphHelper) Diagnose(ctx context.Context, config apiserver.Configuration) apiserver.ConfigurationDiagnostics {
+	var report apiserver.ConfigurationDiagnostics
+	token, err := g.credential.GetToken(ctx, policy.TokenRequestOptions{
+		Scopes: []string{"https://graph.microsoft.com/.default"},
+	})
+	if err != nil {
+		report.Error = common.Ptr(fmt.Sprintf("acquiring token: %v", err))
+		return report
+	}
+	report.Authenticated = true
+
+	claims, err := parseTokenClaims(token.Token)
+	if err != nil {
+		report.Error = common.Ptr(fmt.Sprintf("reading permissions from token: %v", err))
+		return report
+	}
+	granted := claims.Roles
+	report.PermissionType = "application"
+	if g.isDelegated {
+		granted = strings.Fields(claims.Scp)
+		report.PermissionType = "delegated"
+	}
+	report.Ok = true
+	for _, required := range requiredPermissions {
+		check := apiserver.PermissionCheck{Permission: required.name}
+		for _, permission := range granted {
+			if strings.EqualFold(permission, required.name) {
+				check.Granted = true
+				check.GrantedBy = nil
+				break
+			}
+			for _, including := range required.includedIn {
+				if strings.EqualFold(permission, including) {
+					check.Granted = true
+					check.GrantedBy = common.Ptr(permission)
+				}
+			}
+		}
+		report.Ok = report.Ok && check.Granted
+		report.Permissions = append(report.Permissions, check)
+	}
+
+	rooms, err := g.countRooms(ctx)
+	if err != nil {
+		report.RoomsError = common.Ptr(err.Error())
+		report.Ok = false
+	} else {
+		report.Rooms = &rooms
+	}
+
+	// Equipment mailboxes are only told apart by the mailbox settings of each user, which are too
+	// many to read for a diagnosis. The counts of the last synchronization are reported instead.
+	if state, err := conf.GetDeltaState(ctx, config, usersDeltaResource); err == nil && state != nil {
+		if rooms, err := conf.GetDirectoryResources(ctx, config, purposeRoom); err == nil {
+			report.SynchronizedRooms = common.Ptr(int32(len(rooms)))
+		}
+		if equipment, err := conf.GetDirectoryResources(ctx, config, purposeEquipment); err == nil {
+			report.SynchronizedEquipment = common.Ptr(int32(len(equipment)))
+		}
+	}
+
+	if unreadable := UnreadableCalendars(*config.Id); len(unreadable) > 0 {
+		report.UnreadableCalendars = unreadable
+		report.Ok = false
+	}
+	return report
+}
+
+func (g *GraphHelper) countRooms(ctx context.Context) (int32, error) {
+	r, err := g.userClient.Places().GraphRoom().Get(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("querying rooms API: %v", err)
+	}
+	pageIterator, err := msgraphcore.NewPageIterator[*models.Room](
+		r, g.userClient.GetAdapter(), models.CreateRoomCollectionResponseFromDiscriminatorValue,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("getting room iterator: %v", err)
+	}
+	var count int32
+	if err := pageIterator.Iterate(ctx, func(*models.Room) bool {
+		count++
+		// Return true to continue the iteration
+		return true
+	}); err != nil {
+		return 0, fmt.Errorf("iterating rooms: %v", err)
+	}
+	return count, nil
+}
@@ -0,0 +1,27 @@
+package msgraph
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const graphEndpoint = "https://graph.microsoft.com/v1.0/"
+
+// CheckReachability tells whether Microsoft Graph answers at all. The request is not
+// authenticated, any response counts as reachable.
+func CheckReachability(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, graphEndpoint, nil)
+	if err != nil {
+		return fmt.Errorf("creating request: %v", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("requesting Microsoft Graph: %v", err)
+	}
+	resp.Body.Close()
+	return nil
+}
@@ -2,8 +2,12 @@ package msgraph
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"microsoft-365/apiserver"
+	"microsoft-365/conf"
+	"net/http"
+	"strings"
 	"time"
 
 	azcore "github.com/Azure/azure-sdk-for-go/sdk/azcore"
@@ -26,40 +30,33 @@ type GraphHelper struct {
 	userClient      *msgraphsdk.GraphServiceClient
 	graphUserScopes []string
 	isDelegated     bool
+	configID        int64
 }
 
-func NewGraphHelper() *GraphHelper {
-	g := &GraphHelper{}
+func NewGraphHelper(configID int64) *GraphHelper {
+	g := &GraphHelper{configID: configID}
 	return g
 }
 
-func (g *GraphHelper) InitializeGraphForUserAuth(clientId, tenantId string, deviceCode chan string) error {
-	// TODO: This would be better handled by Authorization code credential, but the current
-	// Microsoft Graph Go SDK does not implement it. We must therefore hack around it with device
-	// code.
-	// Also to note, the Interactive flow just tries to open a browser using gtk.
-	//
-	// IMPORTANT: Needs "Allow public client flows" in Entra[1].
-	// [1]https://github.com/microsoftgraph/msgraph-sdk-python-core/issues/153#issuecomment-1785682746
-	credential, err := azidentity.NewDeviceCodeCredential(&azidentity.DeviceCodeCredentialOptions{
-		ClientID: clientId,
-		TenantID: tenantId,
-		UserPrompt: func(ctx context.Context, message azidentity.DeviceCodeMessage) error {
-			deviceCode <- message.UserCode
-			return nil
-		},
-	})
-	if err != nil {
-		return fmt.Errorf("Creating the device code credential: %v", err)
-	}
-	g.credential = credential
-	g.credential.GetToken(context.Background(), policy.TokenRequestOptions{})
-
-	return g.completeAuth()
-}
-
-func (g *GraphHelper) InitializeGraph(clientId, tenantId, clientSecret, username, password string) error {
-	if username != "" {
+func (g *GraphHelper) InitializeGraph(clientId, tenantId, clientSecret, clientCertificate, clientCertificatePassword, username, password string) error {
+	if clientCertificate != "" {
+		certs, key, err := parseCertificate(clientCertificate, clientCertificatePassword)
+		if err != nil {
+			return err
+		}
+		cred, err := azidentity.NewClientCertificateCredential(
+			tenantId,
+			clientId,
+			certs,
+			key,
+			nil,
+		)
+		if err != nil {
+			return fmt.Errorf("creating the client certificate credential: %v", err)
+		}
+		g.credential = cred
+		g.isDelegated = false
+	} else if username != "" {
 		cred, err := azidentity.NewUsernamePasswordCredential(
 			tenantId,
 			clientId,
@@ -95,7 +92,9 @@ func (g *GraphHelper) completeAuth() error {
 		return fmt.Errorf("creating an auth provider: %v", err)
 	}
 
-	adapter, err := msgraphsdk.NewGraphRequestAdapter(authProvider)
+	adapter, err := msgraphsdk.NewGraphRequestAdapterWithParseNodeFactoryAndSerializationWriterFactoryAndHttpClient(
+		authProvider, nil, nil, newGraphHTTPClient(g.configID),
+	)
 	if err != nil {
 		return fmt.Errorf("creating a request adapter: %v", err)
 	}
@@ -104,11 +103,6 @@ func (g *GraphHelper) completeAuth() error {
 	return nil
 }
 
-func (g *GraphHelper) InitiateAuthorization(ctx context.Context) error {
-	_, err := g.userClient.Me().Get(ctx, nil)
-	return err
-}
-
 func (g *GraphHelper) GetUserToken() (*string, error) {
 	token, err := g.credential.GetToken(context.Background(), policy.TokenRequestOptions{
 		Scopes: g.graphUserScopes,
@@ -222,6 +216,71 @@ func mapBookingType(bt *models.BookingType) BookingType {
 type GraphAsset interface {
 	getEmailAddress() *string
 	setOnSchedule(*string)
+	setAvailability(view, nextFreeAt, nextBusyAt *string)
+	setMeetings(current, next meeting)
+}
+
+// Schedule holds the attributes shared by all resources with a calendar. It is embedded in the
+// asset structs, see eliona.UpsertAssetData for how its attributes get to Eliona.
+type Schedule struct {
+	OnSchedule *string `eliona:"on_schedule" subtype:"input"`
+	// To be able to use this information in Eliona Rule engine, we need to use numbers.
+	IsOccupied *int8 `eliona:"is_occupied" subtype:"input"`
+	// One digit per interval of the look-ahead window: 0 = free, 1 = tentative, 2 = busy,
+	// 3 = out of office, 4 = working elsewhere.
+	AvailabilityView *string `eliona:"availability_view" subtype:"input"`
+	NextFreeAt       *string `eliona:"next_free_at" subtype:"input"`
+	NextBusyAt       *string `eliona:"next_busy_at" subtype:"input"`
+	// 1 if a meeting is going on right now, unlike IsOccupied, which tells whether anything is
+	// scheduled within the look-ahead window.
+	IsBusyNow               *int8   `eliona:"is_busy_now" subtype:"input"`
+	CurrentMeetingSubject   *string `eliona:"current_meeting_subject" subtype:"input"`
+	CurrentMeetingStart     *string `eliona:"current_meeting_start" subtype:"input"`
+	CurrentMeetingEnd       *string `eliona:"current_meeting_end" subtype:"input"`
+	CurrentMeetingOrganizer *string `eliona:"current_meeting_organizer" subtype:"input"`
+	CurrentMeetingStatus    *string `eliona:"current_meeting_status" subtype:"input"`
+	CurrentMeetingIsPrivate *int8   `eliona:"current_meeting_is_private" subtype:"input"`
+	NextMeetingSubject      *string `eliona:"next_meeting_subject" subtype:"input"`
+	NextMeetingStart        *string `eliona:"next_meeting_start" subtype:"input"`
+	NextMeetingEnd          *string `eliona:"next_meeting_end" subtype:"input"`
+	NextMeetingOrganizer    *string `eliona:"next_meeting_organizer" subtype:"input"`
+	NextMeetingStatus       *string `eliona:"next_meeting_status" subtype:"input"`
+	NextMeetingIsPrivate    *int8   `eliona:"next_meeting_is_private" subtype:"input"`
+}
+
+func (s *Schedule) setOnSchedule(onSchedule *string) {
+	s.OnSchedule = onSchedule
+	occ := int8(0)
+	if onSchedule != nil {
+		occ = 1
+	}
+	s.IsOccupied = &occ
+}
+
+func (s *Schedule) setAvailability(view, nextFreeAt, nextBusyAt *string) {
+	s.AvailabilityView = view
+	s.NextFreeAt = nextFreeAt
+	s.NextBusyAt = nextBusyAt
+}
+
+func (s *Schedule) setMeetings(current, next meeting) {
+	busyNow := int8(0)
+	if current.Start != nil {
+		busyNow = 1
+	}
+	s.IsBusyNow = &busyNow
+	s.CurrentMeetingSubject = current.Subject
+	s.CurrentMeetingStart = current.Start
+	s.CurrentMeetingEnd = current.End
+	s.CurrentMeetingOrganizer = current.Organizer
+	s.CurrentMeetingStatus = current.Status
+	s.CurrentMeetingIsPrivate = current.IsPrivate
+	s.NextMeetingSubject = next.Subject
+	s.NextMeetingStart = next.Start
+	s.NextMeetingEnd = next.End
+	s.NextMeetingOrganizer = next.Organizer
+	s.NextMeetingStatus = next.Status
+	s.NextMeetingIsPrivate = next.IsPrivate
 }
 
 type Room struct {
@@ -239,12 +298,14 @@ type Room struct {
 	FloorNumber            *int32          `eliona:"floor_number,filterable" subtype:"info"`
 	IsWheelChairAccessible *bool           `eliona:"is_wheel_chair_accessible,filterable" subtype:"info"`
 	Tags                   []string        `eliona:"tags,filterable" subtype:"info"`
+	RoomLists              string          `eliona:"room_lists,filterable" subtype:"info"`
 	DisplayDeviceName      *string         `eliona:"display_device_name,filterable" subtype:"info"`
 	AudioDeviceName        *string         `eliona:"audio_device_name,filterable" subtype:"info"`
 	VideoDeviceName        *string         `eliona:"video_device_name,filterable" subtype:"info"`
-	OnSchedule             *string         `eliona:"on_schedule" subtype:"input"`
-	// To be able to use this information in Eliona Rule engine, we need to use numbers.
-	IsOccupied *int8 `eliona:"is_occupied" subtype:"input"`
+	Schedule
+	// 1 if no presence was seen within the no-show timeout of the current meeting. Only set for
+	// rooms with a presence link.
+	NoShow *int8 `eliona:"no_show" subtype:"input"`
 }
 
 func (room Room) AssetType() string {
@@ -255,6 +316,21 @@ func (room Room) Id() string {
 	return room.AssetType() + "_" + *room.EmailAddress
 }
 
+// Location tells the building and floor of a room or workspace.
+type Location struct {
+	Building    *string
+	FloorNumber *int32
+	FloorLabel  *string
+}
+
+func (room Room) Location() Location {
+	return Location{
+		Building:    room.Building,
+		FloorNumber: room.FloorNumber,
+		FloorLabel:  room.FloorLabel,
+	}
+}
+
 func (room *Room) AdheresToFilter(config apiserver.Configuration) (bool, error) {
 	f := apiFilterToCommonFilter(config.AssetFilter)
 	fp, err := utils.StructToMap(room)
@@ -272,15 +348,6 @@ func (r *Room) getEmailAddress() *string {
 	return r.EmailAddress
 }
 
-func (r *Room) setOnSchedule(s *string) {
-	r.OnSchedule = s
-	occ := int8(0)
-	if s != nil {
-		occ = 1
-	}
-	r.IsOccupied = &occ
-}
-
 func apiFilterToCommonFilter(input [][]apiserver.FilterRule) [][]common.FilterRule {
 	result := make([][]common.FilterRule, len(input))
 	for i := 0; i < len(input); i++ {
@@ -295,45 +362,38 @@ func apiFilterToCommonFilter(input [][]apiserver.FilterRule) [][]common.FilterRu
 	return result
 }
 
+// GetRooms returns the rooms found by SyncDirectory along with their current schedule.
 func (g *GraphHelper) GetRooms(config apiserver.Configuration) ([]Room, error) {
-	r, err := g.userClient.Places().GraphRoom().Get(context.Background(), nil)
-	if err != nil {
-		return nil, fmt.Errorf("querying rooms API: %+v", err)
-	}
-
-	pageIterator, err := msgraphcore.NewPageIterator[*models.Room](
-		r, g.userClient.GetAdapter(), models.CreateRoomFromDiscriminatorValue,
-	)
+	resources, err := conf.GetDirectoryResources(context.Background(), config, purposeRoom)
 	if err != nil {
-		return nil, fmt.Errorf("getting room iterator: %v", err)
+		return nil, fmt.Errorf("getting rooms: %v", err)
 	}
 
 	rooms := make(map[string]*Room)
-	if err := pageIterator.Iterate(context.Background(), func(msroom *models.Room) bool {
-		if msroom == nil {
-			return false
+	for _, resource := range resources {
+		room, err := roomFromResource(resource)
+		if err != nil {
+			return nil, err
 		}
-		room := convertToRoom(*msroom)
 		adheres, err := room.AdheresToFilter(config)
 		if err != nil {
-			log.Error("microsoft-365", "checking if room adheres to a filter: %v", err)
-			return false
+			return nil, fmt.Errorf("checking if room adheres to a filter: %v", err)
 		}
 		if !adheres {
 			log.Debug("microsoft-365", "Room %s skipped.", *room.EmailAddress)
-			return true
+			continue
 		}
 		rooms[*room.EmailAddress] = &room
-		// Return true to continue the iteration
-		return true
-	}); err != nil {
-		return nil, fmt.Errorf("iterating rooms: %v", err)
 	}
 	if len(rooms) == 0 {
 		return []Room{}, nil
 	}
 
-	rooms, err = fetchSchedules(g, rooms)
+	options, err := scheduleOptionsFromConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("getting schedule options: %v", err)
+	}
+	rooms, err = fetchSchedules(g, options, rooms)
 	if err != nil {
 		return nil, fmt.Errorf("fetching schedules: %v", err)
 	}
@@ -348,9 +408,7 @@ func (g *GraphHelper) GetRooms(config apiserver.Configuration) ([]Room, error) {
 type Equipment struct {
 	EmailAddress *string `eliona:"email_address,filterable" subtype:"info"`
 	DisplayName  *string `eliona:"display_name,filterable" subtype:"info"`
-	OnSchedule   *string `eliona:"on_schedule" subtype:"input"`
-	// To be able to use this information in Eliona Rule engine, we need to use numbers.
-	IsOccupied *int8 `eliona:"is_occupied" subtype:"input"`
+	Schedule
 }
 
 func (equipment Equipment) AssetType() string {
@@ -378,76 +436,35 @@ func (e *Equipment) getEmailAddress() *string {
 	return e.EmailAddress
 }
 
-func (e *Equipment) setOnSchedule(s *string) {
-	e.OnSchedule = s
-	occ := int8(0)
-	if s != nil {
-		occ = 1
-	}
-	e.IsOccupied = &occ
-}
-
+// GetEquipment returns the equipment found by SyncDirectory along with its current schedule.
 func (g *GraphHelper) GetEquipment(config apiserver.Configuration) ([]Equipment, error) {
-	// It would be wonderful if this filter worked. For some reason, mailboxSettings
-	// can be accessed only user by user. See
-	// https://feedbackportal.microsoft.com/feedback/idea/65df37d3-8f21-ee11-a81c-002248510ddf
-	// for more info.
-	//
-	// f := "mailboxSettings/userPurpose eq 'equipment'"
-	// requestParameters := &users.UsersRequestBuilderGetQueryParameters{
-	// 	Select: []string{"id", "displayName", "mail", "mailboxSettings"},
-	// 	Filter: &f,
-	// }
-	r, err := g.userClient.Users().Get(context.Background(), nil)
-	if err != nil {
-		return nil, fmt.Errorf("querying users list API: %+v", err)
-	}
-
-	pageIterator, err := msgraphcore.NewPageIterator[*models.User](
-		r, g.userClient.GetAdapter(), models.CreateUserFromDiscriminatorValue,
-	)
+	resources, err := conf.GetDirectoryResources(context.Background(), config, purposeEquipment)
 	if err != nil {
-		return nil, fmt.Errorf("getting users iterator: %v", err)
+		return nil, fmt.Errorf("getting equipment: %v", err)
 	}
 
 	equipment := make(map[string]*Equipment)
-	if err := pageIterator.Iterate(context.Background(), func(msuser *models.User) bool {
-		if msuser == nil {
-			return false
-		}
-		name := *msuser.GetUserPrincipalName()
-		r, err := g.userClient.Users().ByUserId(name).MailboxSettings().Get(context.Background(), nil)
-		if err != nil {
-			log.Error("microsoft-365", "querying users API for user %s: %v", name, err)
-			return true
-		}
-		purpose := r.GetUserPurpose()
-		if *purpose != models.EQUIPMENT_USERPURPOSE {
-			return true
-		}
-
-		e := convertToEquipment(*msuser)
-
+	for _, resource := range resources {
+		e := equipmentFromResource(resource)
 		adheres, err := e.AdheresToFilter(config)
 		if err != nil {
-			log.Error("microsoft-365", "checking if equipment adheres to a filter: %v", err)
-			return false
+			return nil, fmt.Errorf("checking if equipment adheres to a filter: %v", err)
 		}
 		if !adheres {
-			log.Debug("microsoft-365", "Room %s skipped.", *e.EmailAddress)
-			return true
+			log.Debug("microsoft-365", "Equipment %s skipped.", *e.EmailAddress)
+			continue
 		}
 		equipment[*e.EmailAddress] = &e
-		// Return true to continue the iteration
-		return true
-	}); err != nil {
-		return nil, fmt.Errorf("iterating equipment: %v", err)
 	}
 	if len(equipment) == 0 {
 		return []Equipment{}, nil
 	}
 
-	equipment, err = fetchSchedules(g, equipment)
+	options, err := scheduleOptionsFromConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("getting schedule options: %v", err)
+	}
+	equipment, err = fetchSchedules(g, options, equipment)
 	if err != nil {
 		return nil, fmt.Errorf("fetching schedules: %v", err)
 	}
@@ -459,15 +476,14 @@ func (g *GraphHelper) GetEquipment(config apiserver.Configuration) ([]Equipment,
 	return equipmentSlice, nil
 }
 
-func fetchSchedules[T GraphAsset](g *GraphHelper, rooms map[string]T) (map[string]T, error) {
+func fetchSchedules[T GraphAsset](g *GraphHelper, options scheduleOptions, rooms map[string]T) (map[string]T, error) {
+	tz := options.timeZone
 	var addressList []string
 	for i := range rooms {
 		addressList = append(addressList, i)
 	}
-	timeZone := "W. Europe Standard Time"
 	headers := abstractions.NewRequestHeaders()
-	// If not specified, values returned are in UTC.
-	headers.Add("Prefer", fmt.Sprintf("outlook.timezone=\"%s\"", timeZone))
+	headers.Add("Prefer", tz.preferHeader())
 
 	configuration := &users.ItemCalendarGetScheduleRequestBuilderPostRequestConfiguration{
 		Headers: headers,
@@ -477,23 +493,21 @@ func fetchSchedules[T GraphAsset](g *GraphHelper, rooms map[string]T) (map[strin
 
 	startTime := models.NewDateTimeTimeZone()
 	t1 := time.Now()
-	// The docs say "2006-01-02T15:04:05" is the correct format, but RFC3339
-	// is acceptable as well (but undocumented).
-	ts1 := t1.Format("2006-01-02T15:04:05")
+	ts1 := tz.format(t1)
 	startTime.SetDateTime(&ts1)
-	startTime.SetTimeZone(&timeZone)
+	startTime.SetTimeZone(&tz.Windows)
 	requestBody.SetStartTime(startTime)
 
 	endTime := models.NewDateTimeTimeZone()
 
-	t2 := t1.Add(time.Hour)
+	t2 := t1.Add(options.window)
 
-	ts2 := t2.Format("2006-01-02T15:04:05")
+	ts2 := tz.format(t2)
 	endTime.SetDateTime(&ts2)
-	endTime.SetTimeZone(&timeZone)
+	endTime.SetTimeZone(&tz.Windows)
 	requestBody.SetEndTime(endTime)
 
-	availabilityViewInterval := int32(30)
+	availabilityViewInterval := options.interval
 	requestBody.SetAvailabilityViewInterval(&availabilityViewInterval)
 
 	// POST https://graph.microsoft.com/v1.0/me/calendar/getSchedule
@@ -513,31 +527,9 @@ func fetchSchedules[T GraphAsset](g *GraphHelper, rooms map[string]T) (map[strin
 	//     "availabilityViewInterval": 60
 	// }
 
-	// ¯\_(ツ)_/¯
-	//
-	// While it is possible to get schedules of all the "users" in one query, the GetSchedule()
-	// endpoint is accessible only through some user entity. It does not matter which, though,
-	// so we can select one at random.
-	//
-	// Using Me() entity would be more elegant, but that entity is accessible only using
-	// delegated permissions.
-	//
-	// Note: with delegated permissions, only the Me() endpoint is accessible so to test it in
-	// Graph Explorer, use the query above this comment.
-	var r users.ItemCalendarGetScheduleResponseable
-	if g.isDelegated {
-		var err error
-		r, err = g.userClient.Me().Calendar().GetSchedule().Post(context.Background(), requestBody, configuration)
-		if err != nil {
-			return nil, fmt.Errorf("querying calendar API via delegated permission: %+v", err)
-		}
-	} else {
-		randomAddress := addressList[0]
-		var err error
-		r, err = g.userClient.Users().ByUserId(randomAddress).Calendar().GetSchedule().Post(context.Background(), requestBody, configuration)
-		if err != nil {
-			return nil, fmt.Errorf("querying calendar API via app permission: %+v", err)
-		}
+	r, err := g.postGetSchedule(context.Background(), requestBody, configuration, addressList[0])
+	if err != nil {
+		return nil, err
 	}
 
 	pageIterator, err := msgraphcore.NewPageIterator[*models.ScheduleInformation](
@@ -547,6 +539,7 @@ func fetchSchedules[T GraphAsset](g *GraphHelper, rooms map[string]T) (map[strin
 		return nil, fmt.Errorf("getting schedule iterator: %v", err)
 	}
 
+	var lookups []meetingLookup
 	if err := pageIterator.Iterate(context.Background(), func(schedule *models.ScheduleInformation) bool {
 		if schedule == nil {
 			return false
@@ -559,24 +552,84 @@ func fetchSchedules[T GraphAsset](g *GraphHelper, rooms map[string]T) (map[strin
 		scheduleID := *sID
 
 		room := rooms[scheduleID]
+		periods, err := busyPeriods(tz, schedule.GetScheduleItems())
+		if err != nil {
+			log.Error("microsoft-365", "reading schedule of %s: %v", scheduleID, err)
+			return true
+		}
+		// OnSchedule and IsOccupied keep telling whether anything is scheduled within the window,
+		// whether the resource is busy right now is told by IsBusyNow.
 		scheduleItems := schedule.GetScheduleItems()
-		if scheduleItems == nil || len(scheduleItems) == 0 {
+		if len(scheduleItems) == 0 {
 			room.setOnSchedule(nil)
 		} else {
 			d := getScheduleItemableDescription(scheduleItems[0])
 			room.setOnSchedule(&d)
 		}
-		rooms[scheduleID] = room
+		current := currentPeriod(periods, t1)
+		nextFree, nextBusy := nextFreeAndBusy(periods, t1, t2)
+		room.setAvailability(schedule.GetAvailabilityView(), formatTimestamp(tz, nextFree), formatTimestamp(tz, nextBusy))
+
+		next := nextPeriod(periods, t1)
+		if current == nil && next == nil {
+			room.setMeetings(meeting{}, meeting{})
+			return true
+		}
+		from, to := t1, t2
+		if current != nil {
+			from = current.start
+		}
+		if next != nil && next.end.After(to) {
+			to = next.end
+		}
+		lookups = append(lookups, meetingLookup{email: scheduleID, from: from, to: to, current: current, next: next})
 
 		// Return true to continue the iteration.
 		return true
 	}); err != nil {
 		return nil, fmt.Errorf("iterating schedules: %v", err)
 	}
+
+	// The organizers are not part of the schedule. They are read for all busy resources at once.
+	events := g.fetchEventDetails(context.Background(), tz, lookups)
+	for _, l := range lookups {
+		rooms[l.email].setMeetings(newMeeting(tz, l.current, events[l.email]), newMeeting(tz, l.next, events[l.email]))
+	}
 	return rooms, nil
 }
 
+// postGetSchedule queries the schedules requested in the body through the user at randomAddress,
+// or the signed-in user with delegated permissions.
+func (g *GraphHelper) postGetSchedule(ctx context.Context, requestBody users.ItemCalendarGetSchedulePostRequestBodyable, configuration *users.ItemCalendarGetScheduleRequestBuilderPostRequestConfiguration, randomAddress string) (users.ItemCalendarGetScheduleResponseable, error) {
+	// ¯\_(ツ)_/¯
+	//
+	// While it is possible to get schedules of all the "users" in one query, the GetSchedule()
+	// endpoint is accessible only through some user entity. It does not matter which, though,
+	// so we can select one at random.
+	//
+	// Using Me() entity would be more elegant, but that entity is accessible only using
+	// delegated permissions.
+	//
+	// Note: with delegated permissions, only the Me() endpoint is accessible so to test it in
+	// Graph Explorer, use the query in fetchSchedules.
+	if g.isDelegated {
+		r, err := g.userClient.Me().Calendar().GetSchedule().Post(ctx, requestBody, configuration)
+		if err != nil {
+			return nil, fmt.Errorf("querying calendar API via delegated permission: %+v", err)
+		}
+		return r, nil
+	}
+	r, err := g.userClient.Users().ByUserId(randomAddress).Calendar().GetSchedule().Post(ctx, requestBody, configuration)
+	if err != nil {
+		return nil, fmt.Errorf("querying calendar API via app permission: %+v", err)
+	}
+	return r, nil
+}
+
 func getScheduleItemableDescription(i models.ScheduleItemable) string {
+	if p := i.GetIsPrivate(); p != nil && *p {
+		return privateDetail
+	}
 	var result string
 	if s := i.GetStatus(); s != nil {
 		result = s.String()
@@ -631,21 +684,17 @@ func convertToRoom(r models.Room) Room {
 	return room
 }
 
-func convertToEquipment(u models.User) Equipment {
-	return Equipment{
-		DisplayName:  u.GetDisplayName(),
-		EmailAddress: u.GetUserPrincipalName(),
-	}
-}
-
 //
 
-func (g *GraphHelper) ListBookings(ctx context.Context, email, start, end string) ([]apiserver.Booking, error) {
+func (g *GraphHelper) ListBookings(ctx context.Context, tz TimeZone, email, start, end string) ([]apiserver.Booking, error) {
 	filter := fmt.Sprintf("start/dateTime ge '%s' and end/dateTime le '%s'", start, end)
 
+	headers := abstractions.NewRequestHeaders()
+	headers.Add("Prefer", tz.preferHeader())
 	events, err := g.userClient.Users().ByUserId(email).Events().Get(
 		ctx,
 		&users.ItemEventsRequestBuilderGetRequestConfiguration{
+			Headers: headers,
 			QueryParameters: &users.ItemEventsRequestBuilderGetQueryParameters{
 				Filter: &filter,
 			},
@@ -657,28 +706,167 @@ func (g *GraphHelper) ListBookings(ctx context.Context, email, start, end string
 
 	var bookings []apiserver.Booking
 	for _, event := range events.GetValue() {
-		layout := "2006-01-02T15:04:05.9999999"
-		startTime, err := time.Parse(layout, *event.GetStart().GetDateTime())
-		if err != nil {
-			return nil, fmt.Errorf("parsing datetime: %v", err)
-		}
-		endTime, err := time.Parse(layout, *event.GetEnd().GetDateTime())
+		booking, err := bookingFromEvent(tz, event, email, true)
 		if err != nil {
-			return nil, fmt.Errorf("parsing datetime: %v", err)
-		}
-		booking := apiserver.Booking{
-			Id:            *event.GetICalUId(),
-			Start:         startTime,
-			End:           endTime,
-			OrganizerID:   *event.GetOrganizer().GetEmailAddress().GetAddress(),
-			OrganizerName: *event.GetOrganizer().GetEmailAddress().GetName(),
+			return nil, err
 		}
 		bookings = append(bookings, booking)
 	}
 	return bookings, nil
 }
 
-func (g *GraphHelper) CreateBooking(ctx context.Context, startDT, endDT, resourceEmail, subject, description string) error {
+// Responses of the booked resource to a booking. Resource mailboxes respond asynchronously, so
+// new bookings start with ResponseNone.
+const (
+	ResponseAccepted  = "accepted"
+	ResponseDeclined  = "declined"
+	ResponseTentative = "tentative"
+	ResponseNone      = "none"
+)
+
+// bookingFromEvent converts the event to a booking of the resource. ownCalendar tells whether the
+// event was read from the calendar of the resource itself rather than from the organizer's.
+func bookingFromEvent(tz TimeZone, event models.Eventable, resourceEmail string, ownCalendar bool) (apiserver.Booking, error) {
+	startTime, err := tz.parse(*event.GetStart().GetDateTime())
+	if err != nil {
+		return apiserver.Booking{}, fmt.Errorf("parsing datetime: %v", err)
+	}
+	endTime, err := tz.parse(*event.GetEnd().GetDateTime())
+	if err != nil {
+		return apiserver.Booking{}, fmt.Errorf("parsing datetime: %v", err)
+	}
+	booking := apiserver.Booking{
+		Start:          startTime,
+		End:            endTime,
+		ResponseStatus: resourceResponse(event, resourceEmail, ownCalendar),
+	}
+	if id := event.GetICalUId(); id != nil {
+		booking.Id = *id
+	}
+	if organizer := event.GetOrganizer(); organizer != nil && organizer.GetEmailAddress() != nil {
+		if address := organizer.GetEmailAddress().GetAddress(); address != nil {
+			booking.OrganizerID = *address
+		}
+		if name := organizer.GetEmailAddress().GetName(); name != nil {
+			booking.OrganizerName = *name
+		}
+	}
+	return booking, nil
+}
+
+// resourceResponse returns how the resource responded to the event. In the copy of the event in the
+// calendar of the resource, its response is the response status of the event, the attendees there
+// are not updated. Only the organizer's copy tracks the responses of the attendees.
+func resourceResponse(event models.Eventable, resourceEmail string, ownCalendar bool) string {
+	if ownCalendar {
+		if status := event.GetResponseStatus(); status != nil {
+			return mapResponse(status.GetResponse())
+		}
+		return ResponseNone
+	}
+	for _, attendee := range event.GetAttendees() {
+		address := attendee.GetEmailAddress()
+		if address == nil || address.GetAddress() == nil || !strings.EqualFold(*address.GetAddress(), resourceEmail) {
+			continue
+		}
+		if status := attendee.GetStatus(); status != nil {
+			return mapResponse(status.GetResponse())
+		}
+		return ResponseNone
+	}
+	return ResponseNone
+}
+
+func mapResponse(response *models.ResponseType) string {
+	if response == nil {
+		return ResponseNone
+	}
+	switch *response {
+	case models.ACCEPTED_RESPONSETYPE, models.ORGANIZER_RESPONSETYPE:
+		return ResponseAccepted
+	case models.DECLINED_RESPONSETYPE:
+		return ResponseDeclined
+	case models.TENTATIVELYACCEPTED_RESPONSETYPE:
+		return ResponseTentative
+	default:
+		return ResponseNone
+	}
+}
+
+// Booking modes define on whose behalf bookings are created.
+const (
+	// BookingModeDelegated creates bookings as the user signed in with a device code.
+	BookingModeDelegated = "delegated"
+	// BookingModeApplication creates bookings with the app credentials of the configuration.
+	BookingModeApplication = "application"
+)
+
+func (g *GraphHelper) CreateBooking(ctx context.Context, tz TimeZone, startDT, endDT, resourceEmail, subject, description string) (apiserver.Booking, error) {
+	requestBody, err := newBookingEvent(tz, startDT, endDT, resourceEmail, subject, description)
+	if err != nil {
+		return apiserver.Booking{}, err
+	}
+	event, err := g.userClient.Me().Events().Post(ctx, requestBody, eventPostConfiguration(tz))
+	if err != nil {
+		return apiserver.Booking{}, fmt.Errorf("creating event: %v", err)
+	}
+	return bookingFromEvent(tz, event, resourceEmail, false)
+}
+
+// CreateBookingForUser creates the booking with application permissions in the calendar of
+// calendarEmail and invites the user. If the calendar is the one of the booked resource, the
+// resource is booked directly instead of being invited.
+func (g *GraphHelper) CreateBookingForUser(ctx context.Context, tz TimeZone, startDT, endDT, calendarEmail, resourceEmail, userEmail, subject, description string) (apiserver.Booking, error) {
+	if g.isDelegated {
+		return apiserver.Booking{}, fmt.Errorf("booking with application permissions requires a client secret or certificate")
+	}
+	requestBody, err := newBookingEvent(tz, startDT, endDT, resourceEmail, subject, description)
+	if err != nil {
+		return apiserver.Booking{}, err
+	}
+	var attendees []models.Attendeeable
+	if !strings.EqualFold(calendarEmail, resourceEmail) {
+		attendees = requestBody.GetAttendees()
+	}
+	if userEmail != "" {
+		attendees = append(attendees, newAttendee(userEmail))
+	}
+	requestBody.SetAttendees(attendees)
+	event, err := g.userClient.Users().ByUserId(calendarEmail).Events().Post(ctx, requestBody, eventPostConfiguration(tz))
+	if err != nil {
+		return apiserver.Booking{}, fmt.Errorf("creating event in calendar of %s: %v", calendarEmail, err)
+	}
+	return bookingFromEvent(tz, event, resourceEmail, strings.EqualFold(calendarEmail, resourceEmail))
+}
+
+// IsTenantUser tells whether the email address belongs to a person in the tenant, as opposed to an
+// unknown address or a room or equipment mailbox.
+func (g *GraphHelper) IsTenantUser(ctx context.Context, config apiserver.Configuration, email string) (bool, error) {
+	_, err := g.userClient.Users().ByUserId(email).Get(ctx, &users.UserItemRequestBuilderGetRequestConfiguration{
+		QueryParameters: &users.UserItemRequestBuilderGetQueryParameters{
+			Select: []string{"id"},
+		},
+	})
+	var apiErr abstractions.ApiErrorable
+	if errors.As(err, &apiErr) && apiErr.GetStatusCode() == http.StatusNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("querying users API for %s: %v", email, err)
+	}
+	resources, err := conf.GetDirectoryResources(ctx, config, "")
+	if err != nil {
+		return false, fmt.Errorf("getting directory resources: %v", err)
+	}
+	for _, resource := range resources {
+		if strings.EqualFold(resource.Email, email) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func newBookingEvent(tz TimeZone, startDT, endDT, resourceEmail, subject, description string) (models.Eventable, error) {
 	// {
 	//     "subject": "Meeet",
 	//     "body": {
@@ -686,12 +874,12 @@ func (g *GraphHelper) CreateBooking(ctx context.Context, startDT, endDT, resourc
 	//         "content": "Does this time work for you?"
 	//     },
 	//     "start": {
-	//         "dateTime": "2023-10-26T13:29:11.861Z",
-	//         "timeZone": "UTC"
+	//         "dateTime": "2023-10-26T15:29:11",
+	//         "timeZone": "W. Europe Standard Time"
 	//     },
 	//     "end": {
-	//         "dateTime": "2023-10-26T14:29:11.861Z",
-	//         "timeZone": "UTC"
+	//         "dateTime": "2023-10-26T16:29:11",
+	//         "timeZone": "W. Europe Standard Time"
 	//     },
 	//     "attendees": [
 	//         {
@@ -705,8 +893,18 @@ func (g *GraphHelper) CreateBooking(ctx context.Context, startDT, endDT, resourc
 	//         "displayName": "Small Table"
 	//     }
 	// }
+	startTime, err := tz.parseInput(startDT)
+	if err != nil {
+		return nil, fmt.Errorf("parsing start: %v", err)
+	}
+	endTime, err := tz.parseInput(endDT)
+	if err != nil {
+		return nil, fmt.Errorf("parsing end: %v", err)
+	}
+	startLocal := tz.format(startTime)
+	endLocal := tz.format(endTime)
+
 	requestBody := models.NewEvent()
-	timeZone := "UTC"
 
 	requestBody.SetSubject(&subject)
 
@@ -717,33 +915,43 @@ func (g *GraphHelper) CreateBooking(ctx context.Context, startDT, endDT, resourc
 	requestBody.SetBody(body)
 
 	start := models.NewDateTimeTimeZone()
-	start.SetTimeZone(&timeZone)
-	start.SetDateTime(&startDT)
+	start.SetTimeZone(&tz.Windows)
+	start.SetDateTime(&startLocal)
 	requestBody.SetStart(start)
 	end := models.NewDateTimeTimeZone()
-	end.SetDateTime(&endDT)
-	end.SetTimeZone(&timeZone)
+	end.SetDateTime(&endLocal)
+	end.SetTimeZone(&tz.Windows)
 	requestBody.SetEnd(end)
 
-	attendee := models.NewAttendee()
-	emailAddress := models.NewEmailAddress()
-	emailAddress.SetAddress(&resourceEmail)
-	attendee.SetEmailAddress(emailAddress)
-	tpe := models.REQUIRED_ATTENDEETYPE
-	attendee.SetTypeEscaped(&tpe)
 	attendees := []models.Attendeeable{
-		attendee,
+		newAttendee(resourceEmail),
 	}
 	requestBody.SetAttendees(attendees)
 	location := models.NewLocation()
 	location.SetDisplayName(&resourceEmail)
 	requestBody.SetLocation(location)
 
-	_, err := g.userClient.Me().Events().Post(ctx, requestBody, nil)
-	if err != nil {
-		return fmt.Errorf("creating event: %v", err)
+	return requestBody, nil
+}
+
+// eventPostConfiguration makes Graph return the created event in the time zone of the
+// configuration, which bookingFromEvent expects. Otherwise, the times are returned in UTC.
+func eventPostConfiguration(tz TimeZone) *users.ItemEventsRequestBuilderPostRequestConfiguration {
+	headers := abstractions.NewRequestHeaders()
+	headers.Add("Prefer", tz.preferHeader())
+	return &users.ItemEventsRequestBuilderPostRequestConfiguration{
+		Headers: headers,
 	}
-	return nil
+}
+
+func newAttendee(email string) models.Attendeeable {
+	attendee := models.NewAttendee()
+	emailAddress := models.NewEmailAddress()
+	emailAddress.SetAddress(&email)
+	attendee.SetEmailAddress(emailAddress)
+	tpe := models.REQUIRED_ATTENDEETYPE
+	attendee.SetTypeEscaped(&tpe)
+	return attendee
 }
 
 func (g *GraphHelper) DeleteBooking(ctx context.Context, bookingId string) error {
@@ -0,0 +1,64 @@
+package msgraph
+
+import (
+	"testing"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+)
+
+func attendee(address string, response models.ResponseType) models.Attendeeable {
+	a := models.NewAttendee()
+	email := models.NewEmailAddress()
+	email.SetAddress(&address)
+	a.SetEmailAddress(email)
+	status := models.NewResponseStatus()
+	status.SetResponse(&response)
+	a.SetStatus(status)
+	return a
+}
+
+func TestResourceResponseOwnCalendar(t *testing.T) {
+	for _, tt := range []struct {
+		response models.ResponseType
+		want     string
+	}{
+		{models.ACCEPTED_RESPONSETYPE, ResponseAccepted},
+		{models.ORGANIZER_RESPONSETYPE, ResponseAccepted},
+		{models.DECLINED_RESPONSETYPE, ResponseDeclined},
+		{models.TENTATIVELYACCEPTED_RESPONSETYPE, ResponseTentative},
+		{models.NOTRESPONDED_RESPONSETYPE, ResponseNone},
+	} {
+		event := models.NewEvent()
+		status := models.NewResponseStatus()
+		status.SetResponse(&tt.response)
+		event.SetResponseStatus(status)
+		// In the calendar of the resource, the attendees are not updated.
+		event.SetAttendees([]models.Attendeeable{attendee("room@contoso.com", models.NONE_RESPONSETYPE)})
+		if got := resourceResponse(event, "room@contoso.com", true); got != tt.want {
+			t.Errorf("resourceResponse with response %s = %s, want %s", tt.response, got, tt.want)
+		}
+	}
+
+	if got := resourceResponse(models.NewEvent(), "room@contoso.com", true); got != ResponseNone {
+		t.Errorf("resourceResponse without response status = %s, want %s", got, ResponseNone)
+	}
+}
+
+func TestResourceResponseOrganizerCalendar(t *testing.T) {
+	event := models.NewEvent()
+	accepted := models.ACCEPTED_RESPONSETYPE
+	status := models.NewResponseStatus()
+	status.SetResponse(&accepted)
+	event.SetResponseStatus(status)
+	event.SetAttendees([]models.Attendeeable{
+		attendee("someone@contoso.com", models.ACCEPTED_RESPONSETYPE),
+		attendee("Room@Contoso.com", models.DECLINED_RESPONSETYPE),
+	})
+
+	if got := resourceResponse(event, "room@contoso.com", false); got != ResponseDeclined {
+		t.Errorf("resourceResponse = %s, want %s", got, ResponseDeclined)
+	}
+	if got := resourceResponse(event, "other-room@contoso.com", false); got != ResponseNone {
+		t.Errorf("resourceResponse of a resource not invited = %s, want %s", got, ResponseNone)
+	}
+}
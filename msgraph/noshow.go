@@ -0,0 +1,68 @@
+package msgraph
+
+import (
+	"context"
+	"fmt"
+	"microsoft-365/apiserver"
+	"time"
+
+	abstractions "github.com/microsoft/kiota-abstractions-go"
+	"github.com/microsoftgraph/msgraph-sdk-go/users"
+)
+
+const noShowComment = "The room was released because nobody showed up."
+
+// ReleaseRoom declines the meetings of the room starting at the given time, so that the room
+// becomes free again. Requires the Calendars.ReadWrite application permission.
+func (g *GraphHelper) ReleaseRoom(config apiserver.Configuration, email string, start time.Time) error {
+	tz, err := ParseTimeZone(config.TimeZone)
+	if err != nil {
+		return fmt.Errorf("parsing time zone: %v", err)
+	}
+	headers := abstractions.NewRequestHeaders()
+	headers.Add("Prefer", tz.preferHeader())
+	from := start.Format(time.RFC3339)
+	to := start.Add(time.Minute).Format(time.RFC3339)
+	r, err := g.userClient.Users().ByUserId(email).CalendarView().Get(context.Background(),
+		&users.ItemCalendarViewRequestBuilderGetRequestConfiguration{
+			Headers: headers,
+			QueryParameters: &users.ItemCalendarViewRequestBuilderGetQueryParameters{
+				StartDateTime: &from,
+				EndDateTime:   &to,
+				Select:        []string{"id", "start", "isCancelled"},
+			},
+		})
+	if err != nil {
+		return fmt.Errorf("querying calendar view of %s: %v", email, err)
+	}
+
+	declined := 0
+	for _, event := range r.GetValue() {
+		if event.GetId() == nil || event.GetStart() == nil || event.GetStart().GetDateTime() == nil {
+			continue
+		}
+		if c := event.GetIsCancelled(); c != nil && *c {
+			continue
+		}
+		eventStart, err := tz.parse(*event.GetStart().GetDateTime())
+		if err != nil {
+			return fmt.Errorf("parsing start: %v", err)
+		}
+		if !eventStart.Equal(start) {
+			continue
+		}
+		body := users.NewItemEventsItemDeclinePostRequestBody()
+		comment := noShowComment
+		sendResponse := true
+		body.SetComment(&comment)
+		body.SetSendResponse(&sendResponse)
+		if err := g.userClient.Users().ByUserId(email).Events().ByEventId(*event.GetId()).Decline().Post(context.Background(), body, nil); err != nil {
+			return fmt.Errorf("declining meeting of %s: %v", email, err)
+		}
+		declined++
+	}
+	if declined == 0 {
+		return fmt.Errorf("no meeting of %s starts at %s", email, from)
+	}
+	return nil
+}
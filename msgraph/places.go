@@ -0,0 +1,479 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"microsoft-365/apiserver"
+	"microsoft-365/appdb"
+	"microsoft-365/conf"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/eliona-smart-building-assistant/go-eliona/utils"
+	"github.com/eliona-smart-building-assistant/go-utils/common"
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+	"github.com/volatiletech/null/v8"
+)
+
+// Workspaces and desks of Microsoft Places are available only in the beta API of Graph, which
+// the Graph SDK does not cover. They are therefore read with raw requests.
+const betaEndpoint = "https://graph.microsoft.com/beta/"
+
+const (
+	purposeWorkspace = "workspace"
+	purposeDesk      = "desk"
+)
+
+// placesRecheckInterval defines how long the places API is not asked again after it turned out to
+// be unavailable for the tenant, e.g. because it has no Microsoft Places.
+const placesRecheckInterval = 24 * time.Hour
+
+// placesUnavailableSince remembers when the places API was found to be unavailable, by
+// configuration ID.
+var placesUnavailableSince sync.Map
+
+type Workspace struct {
+	PlaceID                *string         `eliona:"place_id,filterable" subtype:"info"`
+	Address                PhysicalAddress `eliona:"address,filterable" subtype:"info"`
+	DisplayName            *string         `eliona:"display_name,filterable" subtype:"info"`
+	Nickname               *string         `eliona:"nickname,filterable" subtype:"info"`
+	Label                  *string         `eliona:"label,filterable" subtype:"info"`
+	GeoCoordinates         GeoCoordinates  `eliona:"geo_coordinates,filterable" subtype:"info"`
+	Phone                  *string         `eliona:"phone,filterable" subtype:"info"`
+	EmailAddress           *string         `eliona:"email_address,filterable" subtype:"info"`
+	Mode                   *string         `eliona:"mode,filterable" subtype:"info"`
+	Building               *string         `eliona:"building,filterable" subtype:"info"`
+	Capacity               *int32          `eliona:"capacity,filterable" subtype:"info"`
+	FloorLabel             *string         `eliona:"floor_label,filterable" subtype:"info"`
+	FloorNumber            *int32          `eliona:"floor_number,filterable" subtype:"info"`
+	IsWheelChairAccessible *bool           `eliona:"is_wheel_chair_accessible,filterable" subtype:"info"`
+	Tags                   []string        `eliona:"tags,filterable" subtype:"info"`
+	Schedule
+}
+
+func (workspace Workspace) AssetType() string {
+	return "microsoft_365_workspace"
+}
+
+func (workspace Workspace) Id() string {
+	return workspace.AssetType() + "_" + *workspace.EmailAddress
+}
+
+func (workspace Workspace) Location() Location {
+	return Location{
+		Building:    workspace.Building,
+		FloorNumber: workspace.FloorNumber,
+		FloorLabel:  workspace.FloorLabel,
+	}
+}
+
+func (workspace *Workspace) AdheresToFilter(config apiserver.Configuration) (bool, error) {
+	f := apiFilterToCommonFilter(config.AssetFilter)
+	fp, err := utils.StructToMap(workspace)
+	if err != nil {
+		return false, fmt.Errorf("converting struct to map: %v", err)
+	}
+	adheres, err := common.Filter(f, fp)
+	if err != nil {
+		return false, err
+	}
+	return adheres, nil
+}
+
+func (w *Workspace) getEmailAddress() *string {
+	return w.EmailAddress
+}
+
+// GetWorkspaces returns the workspaces found by SyncDirectory along with their current schedule.
+func (g *GraphHelper) GetWorkspaces(config apiserver.Configuration) ([]Workspace, error) {
+	resources, err := conf.GetDirectoryResources(context.Background(), config, purposeWorkspace)
+	if err != nil {
+		return nil, fmt.Errorf("getting workspaces: %v", err)
+	}
+
+	workspaces := make(map[string]*Workspace)
+	for _, resource := range resources {
+		var workspace Workspace
+		if err := json.Unmarshal(resource.Room.JSON, &workspace); err != nil {
+			return nil, fmt.Errorf("unmarshalling workspace %s: %v", resource.Email, err)
+		}
+		adheres, err := workspace.AdheresToFilter(config)
+		if err != nil {
+			return nil, fmt.Errorf("checking if workspace adheres to a filter: %v", err)
+		}
+		if !adheres {
+			log.Debug("microsoft-365", "Workspace %s skipped.", *workspace.EmailAddress)
+			continue
+		}
+		workspaces[*workspace.EmailAddress] = &workspace
+	}
+	if len(workspaces) == 0 {
+		return []Workspace{}, nil
+	}
+
+	options, err := scheduleOptionsFromConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("getting schedule options: %v", err)
+	}
+	workspaces, err = fetchSchedules(g, options, workspaces)
+	if err != nil {
+		return nil, fmt.Errorf("fetching schedules: %v", err)
+	}
+
+	var workspacesSlice []Workspace
+	for _, workspace := range workspaces {
+		workspacesSlice = append(workspacesSlice, *workspace)
+	}
+	return workspacesSlice, nil
+}
+
+type Desk struct {
+	PlaceID                *string  `eliona:"place_id,filterable" subtype:"info"`
+	DisplayName            *string  `eliona:"display_name,filterable" subtype:"info"`
+	Label                  *string  `eliona:"label,filterable" subtype:"info"`
+	EmailAddress           *string  `eliona:"email_address,filterable" subtype:"info"`
+	Mode                   *string  `eliona:"mode,filterable" subtype:"info"`
+	AssignedTo             *string  `eliona:"assigned_to,filterable" subtype:"info"`
+	ParentID               *string  `eliona:"parent_id,filterable" subtype:"info"`
+	HeightAdjustableState  *string  `eliona:"height_adjustable_state,filterable" subtype:"info"`
+	IsWheelChairAccessible *bool    `eliona:"is_wheel_chair_accessible,filterable" subtype:"info"`
+	Tags                   []string `eliona:"tags,filterable" subtype:"info"`
+	// Only known for desks with a mailbox, i.e. reservable desks.
+	Schedule
+}
+
+func (desk Desk) AssetType() string {
+	return "microsoft_365_desk"
+}
+
+// Id identifies desks by their place ID, as not every desk has a mailbox.
+func (desk Desk) Id() string {
+	return desk.AssetType() + "_" + *desk.PlaceID
+}
+
+func (desk *Desk) AdheresToFilter(config apiserver.Configuration) (bool, error) {
+	f := apiFilterToCommonFilter(config.AssetFilter)
+	fp, err := utils.StructToMap(desk)
+	if err != nil {
+		return false, fmt.Errorf("converting struct to map: %v", err)
+	}
+	adheres, err := common.Filter(f, fp)
+	if err != nil {
+		return false, err
+	}
+	return adheres, nil
+}
+
+func (d *Desk) getEmailAddress() *string {
+	return d.EmailAddress
+}
+
+// GetDesks returns the desks found by SyncDirectory. Desks with a mailbox come along with their
+// current schedule.
+func (g *GraphHelper) GetDesks(config apiserver.Configuration) ([]Desk, error) {
+	resources, err := conf.GetDirectoryResources(context.Background(), config, purposeDesk)
+	if err != nil {
+		return nil, fmt.Errorf("getting desks: %v", err)
+	}
+
+	var desks []Desk
+	bookable := make(map[string]*Desk)
+	for _, resource := range resources {
+		var desk Desk
+		if err := json.Unmarshal(resource.Room.JSON, &desk); err != nil {
+			return nil, fmt.Errorf("unmarshalling desk %s: %v", resource.UserID, err)
+		}
+		adheres, err := desk.AdheresToFilter(config)
+		if err != nil {
+			return nil, fmt.Errorf("checking if desk adheres to a filter: %v", err)
+		}
+		if !adheres {
+			log.Debug("microsoft-365", "Desk %s skipped.", *desk.PlaceID)
+			continue
+		}
+		if desk.EmailAddress != nil && *desk.EmailAddress != "" {
+			bookable[*desk.EmailAddress] = &desk
+		} else {
+			desks = append(desks, desk)
+		}
+	}
+
+	if len(bookable) != 0 {
+		options, err := scheduleOptionsFromConfig(config)
+		if err != nil {
+			return nil, fmt.Errorf("getting schedule options: %v", err)
+		}
+		bookable, err = fetchSchedules(g, options, bookable)
+		if err != nil {
+			return nil, fmt.Errorf("fetching schedules: %v", err)
+		}
+	}
+	for _, desk := range bookable {
+		desks = append(desks, *desk)
+	}
+	if desks == nil {
+		return []Desk{}, nil
+	}
+	return desks, nil
+}
+
+// betaPlace is a workspace or desk as returned by the beta places API.
+type betaPlace struct {
+	ID                     string   `json:"id"`
+	PlaceID                *string  `json:"placeId"`
+	DisplayName            *string  `json:"displayName"`
+	Nickname               *string  `json:"nickname"`
+	Label                  *string  `json:"label"`
+	Phone                  *string  `json:"phone"`
+	EmailAddress           *string  `json:"emailAddress"`
+	Building               *string  `json:"building"`
+	Capacity               *int32   `json:"capacity"`
+	FloorLabel             *string  `json:"floorLabel"`
+	FloorNumber            *int32   `json:"floorNumber"`
+	IsWheelChairAccessible *bool    `json:"isWheelChairAccessible"`
+	Tags                   []string `json:"tags"`
+	ParentID               *string  `json:"parentId"`
+	HeightAdjustableState  *string  `json:"heightAdjustableState"`
+	Address                *struct {
+		City            *string `json:"city"`
+		CountryOrRegion *string `json:"countryOrRegion"`
+		PostalCode      *string `json:"postalCode"`
+		State           *string `json:"state"`
+		Street          *string `json:"street"`
+	} `json:"address"`
+	GeoCoordinates *struct {
+		Accuracy         *float64 `json:"accuracy"`
+		Altitude         *float64 `json:"altitude"`
+		AltitudeAccuracy *float64 `json:"altitudeAccuracy"`
+		Latitude         *float64 `json:"latitude"`
+		Longitude        *float64 `json:"longitude"`
+	} `json:"geoCoordinates"`
+	Mode *struct {
+		Type                     string  `json:"@odata.type"`
+		AssignedUserEmailAddress *string `json:"assignedUserEmailAddress"`
+	} `json:"mode"`
+	MailboxDetails *struct {
+		EmailAddress *string `json:"emailAddress"`
+	} `json:"mailboxDetails"`
+}
+
+// mode returns the booking mode of the place, i.e. reservable, dropIn or assigned.
+func (p betaPlace) mode() *string {
+	if p.Mode == nil || p.Mode.Type == "" {
+		return nil
+	}
+	mode := strings.TrimSuffix(strings.TrimPrefix(p.Mode.Type, "#microsoft.graph."), "PlaceMode")
+	return &mode
+}
+
+func (p betaPlace) toWorkspace() Workspace {
+	workspace := Workspace{
+		PlaceID:                p.PlaceID,
+		DisplayName:            p.DisplayName,
+		Nickname:               p.Nickname,
+		Label:                  p.Label,
+		Phone:                  p.Phone,
+		EmailAddress:           p.EmailAddress,
+		Mode:                   p.mode(),
+		Building:               p.Building,
+		Capacity:               p.Capacity,
+		FloorLabel:             p.FloorLabel,
+		FloorNumber:            p.FloorNumber,
+		IsWheelChairAccessible: p.IsWheelChairAccessible,
+		Tags:                   p.Tags,
+	}
+	if workspace.PlaceID == nil {
+		workspace.PlaceID = &p.ID
+	}
+	if p.Address != nil {
+		workspace.Address = PhysicalAddress{
+			City:            p.Address.City,
+			CountryOrRegion: p.Address.CountryOrRegion,
+			PostalCode:      p.Address.PostalCode,
+			State:           p.Address.State,
+			Street:          p.Address.Street,
+		}
+	}
+	if p.GeoCoordinates != nil {
+		workspace.GeoCoordinates = GeoCoordinates{
+			Accuracy:         p.GeoCoordinates.Accuracy,
+			Altitude:         p.GeoCoordinates.Altitude,
+			AltitudeAccuracy: p.GeoCoordinates.AltitudeAccuracy,
+			Latitude:         p.GeoCoordinates.Latitude,
+			Longitude:        p.GeoCoordinates.Longitude,
+		}
+	}
+	return workspace
+}
+
+func (p betaPlace) toDesk() Desk {
+	desk := Desk{
+		PlaceID:                p.PlaceID,
+		DisplayName:            p.DisplayName,
+		Label:                  p.Label,
+		Mode:                   p.mode(),
+		ParentID:               p.ParentID,
+		HeightAdjustableState:  p.HeightAdjustableState,
+		IsWheelChairAccessible: p.IsWheelChairAccessible,
+		Tags:                   p.Tags,
+	}
+	if desk.PlaceID == nil {
+		desk.PlaceID = &p.ID
+	}
+	if p.MailboxDetails != nil {
+		desk.EmailAddress = p.MailboxDetails.EmailAddress
+	}
+	if p.Mode != nil {
+		desk.AssignedTo = p.Mode.AssignedUserEmailAddress
+	}
+	return desk
+}
+
+// syncPlaces updates the workspaces and desks stored for the configuration. The places API offers
+// no delta queries, so all of them are read in every run.
+func (g *GraphHelper) syncPlaces(ctx context.Context, config apiserver.Configuration) error {
+	if since, ok := placesUnavailableSince.Load(*config.Id); ok && time.Since(since.(time.Time)) < placesRecheckInterval {
+		log.Debug("microsoft-365", "Microsoft Places unavailable, workspaces and desks skipped.")
+		return nil
+	}
+	workspaces, err := listBetaPlaces(ctx, g, "workspace")
+	var statusErr *betaStatusError
+	if errors.As(err, &statusErr) && (statusErr.status == http.StatusForbidden || statusErr.status == http.StatusNotFound) {
+		placesUnavailableSince.Store(*config.Id, time.Now())
+		log.Info("microsoft-365", "Microsoft Places is not available for configuration %d, workspaces and desks are not synchronized: %v", *config.Id, err)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("listing workspaces: %v", err)
+	}
+	desks, err := listBetaPlaces(ctx, g, "desk")
+	if err != nil {
+		return fmt.Errorf("listing desks: %v", err)
+	}
+
+	current := make(map[string]bool)
+	for _, p := range workspaces {
+		if p.EmailAddress == nil || *p.EmailAddress == "" {
+			log.Debug("microsoft-365", "Workspace %s without mailbox skipped.", p.ID)
+			continue
+		}
+		if err := storePlace(ctx, config, p, purposeWorkspace, *p.EmailAddress, p.toWorkspace()); err != nil {
+			return err
+		}
+		current[p.ID] = true
+	}
+	for _, p := range desks {
+		desk := p.toDesk()
+		var email string
+		if desk.EmailAddress != nil {
+			email = *desk.EmailAddress
+		}
+		if err := storePlace(ctx, config, p, purposeDesk, email, desk); err != nil {
+			return err
+		}
+		current[p.ID] = true
+	}
+
+	for _, purpose := range []string{purposeWorkspace, purposeDesk} {
+		stored, err := conf.GetDirectoryResources(ctx, config, purpose)
+		if err != nil {
+			return fmt.Errorf("getting directory resources: %v", err)
+		}
+		for _, resource := range stored {
+			if current[resource.UserID] {
+				continue
+			}
+			log.Debug("microsoft-365", "Place %s removed from Microsoft Places.", resource.UserID)
+			if err := conf.DeleteDirectoryResource(ctx, config, resource.UserID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// storePlace stores the workspace or desk as directory resource, identified by its ID in Microsoft
+// Places. The details are kept in the same column as those of rooms.
+func storePlace(ctx context.Context, config apiserver.Configuration, p betaPlace, purpose, email string, details any) error {
+	marshalled, err := json.Marshal(details)
+	if err != nil {
+		return fmt.Errorf("marshalling %s %s: %v", purpose, p.ID, err)
+	}
+	var name string
+	if p.DisplayName != nil {
+		name = *p.DisplayName
+	}
+	return conf.UpsertDirectoryResource(ctx, config, &appdb.DirectoryResource{
+		UserID:      p.ID,
+		Email:       email,
+		Purpose:     purpose,
+		DisplayName: name,
+		Room:        null.JSONFrom(marshalled),
+	})
+}
+
+// listBetaPlaces returns all places of the type, following the pages of the response.
+func listBetaPlaces(ctx context.Context, g *GraphHelper, placeType string) ([]betaPlace, error) {
+	url := betaEndpoint + "places/microsoft.graph." + placeType
+	var places []betaPlace
+	for url != "" {
+		var page struct {
+			Value    []betaPlace `json:"value"`
+			NextLink string      `json:"@odata.nextLink"`
+		}
+		if err := g.getBeta(ctx, url, &page); err != nil {
+			return nil, err
+		}
+		places = append(places, page.Value...)
+		url = page.NextLink
+	}
+	return places, nil
+}
+
+func (g *GraphHelper) getBeta(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("creating request: %v", err)
+	}
+	// Refers to all permissions
+	scopes := []string{"https://graph.microsoft.com/.default"}
+	token, err := g.credential.GetToken(ctx, policy.TokenRequestOptions{Scopes: scopes})
+	if err != nil {
+		return fmt.Errorf("getting bearer token: %v", err)
+	}
+	req.Header.Add("Authorization", "Bearer "+token.Token)
+
+	resp, err := newHTTPClient(g.configID).Do(req)
+	if err != nil {
+		return fmt.Errorf("requesting %s: %v", url, err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading response of %s: %v", url, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return &betaStatusError{url: url, status: resp.StatusCode, body: body}
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("parsing response of %s: %v", url, err)
+	}
+	return nil
+}
+
+// betaStatusError is returned by getBeta for responses other than 200 OK.
+type betaStatusError struct {
+	url    string
+	status int
+	body   []byte
+}
+
+func (e *betaStatusError) Error() string {
+	return fmt.Sprintf("requesting %s: %d %s: %s", e.url, e.status, http.StatusText(e.status), e.body)
+}
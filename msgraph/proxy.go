@@ -38,7 +38,8 @@ func (proxy *Proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		configs, err = conf.GetConfigsForProxyWithProjectId(r.Context(), projectID)
 	}
 	if err != nil {
-		log.Fatal("conf", "Couldn't read configs from DB: %v", err)
+		log.Error("conf", "Couldn't read configs from DB: %v", err)
+		writeError(w, http.StatusServiceUnavailable, "Error reading configurations: "+err.Error())
 		return
 	}
 	if len(configs) == 0 {
@@ -47,18 +48,15 @@ func (proxy *Proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	var responses []Response
 	for _, config := range configs {
-		graph := NewGraphHelper()
-		if config.ClientSecret == nil || config.Username == nil || config.Password == nil {
-			log.Error("conf", "Shouldn't happen: some values are nil")
-			return
-		}
-		if err := graph.InitializeGraph(config.ClientId, config.TenantId, *config.ClientSecret, *config.Username, *config.Password); err != nil {
-			log.Error("microsoft-365", "initializing graph for user auth: %v", err)
+		graph, err := GetGraph(config)
+		if err != nil {
+			log.Error("microsoft-365", "getting graph for configuration %d: %v", *config.Id, err)
+			writeError(w, http.StatusBadGateway, fmt.Sprintf("Error connecting to Microsoft Graph API for configuration %d: %v", *config.Id, err))
 			return
 		}
 
 		requestURL := "https://graph.microsoft.com/v1.0/" + r.URL.Path
-		log.Info("microsoft-365", requestURL)
+		log.Info("microsoft-365", "%s", requestURL)
 
 		graphReq, err := http.NewRequest(r.Method, requestURL, r.Body)
 		if err != nil {
@@ -83,7 +81,7 @@ func (proxy *Proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 		graphReq.Header.Add("Authorization", "Bearer "+token.Token)
 
-		graphRes, err := http.DefaultClient.Do(graphReq)
+		graphRes, err := newHTTPClient(*config.Id).Do(graphReq)
 		if err != nil {
 			http.Error(w, "Error sending request to Microsoft Graph API: "+err.Error(), http.StatusInternalServerError)
 			return
@@ -134,3 +132,8 @@ func (proxy *Proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "Error encoding response: "+err.Error(), http.StatusInternalServerError)
 	}
 }
+
+// writeError answers with a JSON error, so that clients of the proxy can parse every response.
+func writeError(w http.ResponseWriter, code int, msg string) {
+	apiserver.EncodeJSONResponse(map[string]string{"error": msg}, &code, w)
+}
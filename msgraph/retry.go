@@ -0,0 +1,206 @@
+package msgraph
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+	khttp "github.com/microsoft/kiota-http-go"
+	msgraphsdk "github.com/microsoftgraph/msgraph-sdk-go"
+	msgraphcore "github.com/microsoftgraph/msgraph-sdk-go-core"
+)
+
+const (
+	maxRetries     = 5
+	baseRetryDelay = time.Second
+	// maxBackoff limits the exponential backoff. Delays given by Graph are not limited.
+	maxBackoff = time.Minute
+)
+
+// ThrottlingCounters counts how often Graph throttled the requests of a configuration since the
+// app started.
+type ThrottlingCounters struct {
+	// Responses with status 429 or 503.
+	Throttled int64 `json:"throttled"`
+	// Requests sent again after being throttled.
+	Retries int64 `json:"retries"`
+	// Requests that were still throttled after the last retry.
+	Failures int64 `json:"failures"`
+}
+
+type throttlingCounters struct {
+	throttled atomic.Int64
+	retries   atomic.Int64
+	failures  atomic.Int64
+}
+
+var counters sync.Map // map[int64]*throttlingCounters
+
+func countersFor(configID int64) *throttlingCounters {
+	c, _ := counters.LoadOrStore(configID, &throttlingCounters{})
+	return c.(*throttlingCounters)
+}
+
+// GetThrottlingCounters returns the throttling counters of the configuration.
+func GetThrottlingCounters(configID int64) ThrottlingCounters {
+	c := countersFor(configID)
+	return ThrottlingCounters{
+		Throttled: c.throttled.Load(),
+		Retries:   c.retries.Load(),
+		Failures:  c.failures.Load(),
+	}
+}
+
+// retryTransport sends throttled requests again. It waits as long as the Retry-After header says,
+// or backs off exponentially with jitter if there is none. Requests that would have to wait
+// beyond the deadline of their context fail right away with the throttled response.
+type retryTransport struct {
+	next     http.RoundTripper
+	configID int64
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// The body must be read again for every retry.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		req = req.Clone(req.Context())
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("reading request body: %v", err)
+		}
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+		req.Body, _ = req.GetBody()
+	}
+
+	c := countersFor(t.configID)
+	for attempt := 0; ; attempt++ {
+		resp, err := t.next.RoundTrip(req)
+		if err != nil || !isThrottled(resp) {
+			return resp, err
+		}
+		c.throttled.Add(1)
+		if !isRetryable(req, resp) {
+			c.failures.Add(1)
+			log.Warn("microsoft-365", "Configuration %d: %s %s unavailable (%d), not retried as it might have been processed.", t.configID, req.Method, req.URL.Path, resp.StatusCode)
+			return resp, nil
+		}
+		if attempt == maxRetries {
+			c.failures.Add(1)
+			log.Warn("microsoft-365", "Configuration %d: %s %s still throttled after %d retries.", t.configID, req.Method, req.URL.Path, maxRetries)
+			return resp, nil
+		}
+
+		delay := retryDelay(resp, attempt)
+		if deadline, ok := req.Context().Deadline(); ok && time.Now().Add(delay).After(deadline) {
+			c.failures.Add(1)
+			log.Warn("microsoft-365", "Configuration %d: %s %s throttled for %v, beyond the deadline of the request.", t.configID, req.Method, req.URL.Path, delay)
+			return resp, nil
+		}
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		log.Debug("microsoft-365", "Configuration %d: %s %s throttled (%d), retrying in %v.", t.configID, req.Method, req.URL.Path, resp.StatusCode, delay)
+
+		if err := sleep(req.Context(), delay); err != nil {
+			return nil, err
+		}
+
+		c.retries.Add(1)
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("resetting request body: %v", err)
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+func isThrottled(resp *http.Response) bool {
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable
+}
+
+// isRetryable tells whether the throttled request can be sent again. Graph rejects requests with
+// 429 before processing them, but a 503 may come after a POST created something, e.g. an event.
+func isRetryable(req *http.Request, resp *http.Response) bool {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryDelay returns the delay given by the Retry-After header, which is either in seconds or an
+// HTTP date. Without it, the delay doubles with each attempt.
+func retryDelay(resp *http.Response, attempt int) time.Duration {
+	return retryAfterDelay(resp.Header.Get("Retry-After"), attempt)
+}
+
+// retryAfterDelay returns the delay given by a Retry-After value, or backs off exponentially if
+// the value is empty or invalid.
+func retryAfterDelay(h string, attempt int) time.Duration {
+	if h != "" {
+		if seconds, err := strconv.Atoi(h); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second
+		}
+		if t, err := http.ParseTime(h); err == nil {
+			return max(time.Until(t), 0)
+		}
+	}
+	backoff := min(baseRetryDelay<<attempt, maxBackoff)
+	// Full jitter spreads the retries of parallel requests.
+	return rand.N(backoff) + baseRetryDelay/2
+}
+
+// sleep waits for the delay unless the context ends before.
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// newHTTPClient returns a client for raw Graph requests of the configuration.
+func newHTTPClient(configID int64) *http.Client {
+	return &http.Client{
+		Transport: &retryTransport{next: http.DefaultTransport, configID: configID},
+	}
+}
+
+// newGraphHTTPClient returns a client for the Graph SDK, with the default middleware of the SDK
+// but retrying throttled requests in retryTransport instead of the retry handler of the SDK.
+func newGraphHTTPClient(configID int64) *http.Client {
+	var middlewares []khttp.Middleware
+	options := msgraphsdk.GetDefaultClientOptions()
+	for _, m := range msgraphcore.GetDefaultMiddlewaresWithOptions(&options) {
+		if _, ok := m.(*khttp.RetryHandler); ok {
+			continue
+		}
+		middlewares = append(middlewares, m)
+	}
+	client := khttp.GetDefaultClient(middlewares...)
+	client.Transport = khttp.NewCustomTransportWithParentTransport(
+		&retryTransport{next: khttp.GetDefaultTransport(), configID: configID},
+		middlewares...,
+	)
+	// The default timeout of the SDK would not leave time for the retries.
+	client.Timeout = 10 * time.Minute
+	return client
+}
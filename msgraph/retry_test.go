@@ -0,0 +1,80 @@
+package msgraph
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func throttledResponse(status int, retryAfter string) *http.Response {
+	resp := &http.Response{StatusCode: status, Header: make(http.Header)}
+	if retryAfter != "" {
+		resp.Header.Set("Retry-After", retryAfter)
+	}
+	return resp
+}
+
+func TestRetryDelayRetryAfterSeconds(t *testing.T) {
+	// Delays given by Graph are not limited by maxBackoff.
+	for _, tt := range []struct {
+		retryAfter string
+		want       time.Duration
+	}{
+		{"0", 0},
+		{"5", 5 * time.Second},
+		{"300", 5 * time.Minute},
+	} {
+		if got := retryDelay(throttledResponse(http.StatusTooManyRequests, tt.retryAfter), 0); got != tt.want {
+			t.Errorf("retryDelay with Retry-After %s = %v, want %v", tt.retryAfter, got, tt.want)
+		}
+	}
+}
+
+func TestRetryDelayRetryAfterDate(t *testing.T) {
+	retryAfter := time.Now().Add(30 * time.Second).UTC().Format(http.TimeFormat)
+	got := retryDelay(throttledResponse(http.StatusServiceUnavailable, retryAfter), 0)
+	// The date has a resolution of one second.
+	if got < 28*time.Second || got > 30*time.Second {
+		t.Errorf("retryDelay with Retry-After %s = %v, want about 30s", retryAfter, got)
+	}
+
+	past := time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat)
+	if got := retryDelay(throttledResponse(http.StatusServiceUnavailable, past), 0); got != 0 {
+		t.Errorf("retryDelay with Retry-After in the past = %v, want 0", got)
+	}
+}
+
+func TestRetryDelayBackoff(t *testing.T) {
+	for _, retryAfter := range []string{"", "soon", "-1"} {
+		for attempt := range 10 {
+			got := retryDelay(throttledResponse(http.StatusTooManyRequests, retryAfter), attempt)
+			backoff := min(baseRetryDelay<<attempt, maxBackoff)
+			if got < baseRetryDelay/2 || got >= backoff+baseRetryDelay/2 {
+				t.Errorf("retryDelay with Retry-After %q in attempt %d = %v, want between %v and %v", retryAfter, attempt, got, baseRetryDelay/2, backoff+baseRetryDelay/2)
+			}
+		}
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	for _, tt := range []struct {
+		method string
+		status int
+		want   bool
+	}{
+		{http.MethodGet, http.StatusTooManyRequests, true},
+		{http.MethodGet, http.StatusServiceUnavailable, true},
+		{http.MethodPost, http.StatusTooManyRequests, true},
+		{http.MethodPost, http.StatusServiceUnavailable, false},
+		{http.MethodPatch, http.StatusServiceUnavailable, false},
+		{http.MethodDelete, http.StatusServiceUnavailable, true},
+	} {
+		req, err := http.NewRequest(tt.method, "https://graph.microsoft.com/v1.0/me", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := isRetryable(req, throttledResponse(tt.status, "")); got != tt.want {
+			t.Errorf("isRetryable(%s, %d) = %v, want %v", tt.method, tt.status, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"microsoft-365/apiserver"
+	"microsoft-365/appdb"
+	"microsoft-365/conf"
+	"slices"
+	"strings"
+
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+	msgraphcore "github.com/microsoftgraph/msgraph-sdk-go-core"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/volatiletech/null/v8"
+)
+
+// Room lists are distribution groups, so they do not show up as users in the users delta.
+const purposeRoomList = "roomList"
+
+type RoomList struct {
+	EmailAddress string
+	DisplayName  string
+}
+
+func (roomList RoomList) AssetType() string {
+	return "microsoft_365_room_list"
+}
+
+func (roomList RoomList) Id() string {
+	return roomList.AssetType() + "_" + roomList.EmailAddress
+}
+
+// GetRoomLists returns the room lists found by SyncDirectory.
+func (g *GraphHelper) GetRoomLists(config apiserver.Configuration) ([]RoomList, error) {
+	resources, err := conf.GetDirectoryResources(context.Background(), config, purposeRoomList)
+	if err != nil {
+		return nil, fmt.Errorf("getting room lists: %v", err)
+	}
+	roomLists := make([]RoomList, 0, len(resources))
+	for _, resource := range resources {
+		roomLists = append(roomLists, RoomList{
+			EmailAddress: resource.Email,
+			DisplayName:  resource.DisplayName,
+		})
+	}
+	return roomLists, nil
+}
+
+// syncRoomLists updates the room lists stored for the configuration and the room lists each room
+// belongs to, kept as sorted, lower-cased email addresses joined by commas so asset filters can
+// match them.
+func (g *GraphHelper) syncRoomLists(ctx context.Context, config apiserver.Configuration) error {
+	r, err := g.userClient.Places().GraphRoomList().Get(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("querying room lists API: %+v", err)
+	}
+	pageIterator, err := msgraphcore.NewPageIterator[*models.RoomList](
+		r, g.userClient.GetAdapter(), models.CreateRoomListCollectionResponseFromDiscriminatorValue,
+	)
+	if err != nil {
+		return fmt.Errorf("getting room list iterator: %v", err)
+	}
+	var roomLists []*models.RoomList
+	if err := pageIterator.Iterate(ctx, func(msroomList *models.RoomList) bool {
+		if msroomList != nil && msroomList.GetId() != nil && msroomList.GetEmailAddress() != nil {
+			roomLists = append(roomLists, msroomList)
+		}
+		// Return true to continue the iteration
+		return true
+	}); err != nil {
+		return fmt.Errorf("iterating room lists: %v", err)
+	}
+
+	current := make(map[string]bool)
+	memberships := make(map[string][]string)
+	for _, msroomList := range roomLists {
+		email := *msroomList.GetEmailAddress()
+		members, err := g.getRoomListMembers(ctx, email)
+		if err != nil {
+			return fmt.Errorf("getting rooms of room list %s: %v", email, err)
+		}
+		for _, member := range members {
+			memberships[member] = append(memberships[member], strings.ToLower(email))
+		}
+
+		resource := &appdb.DirectoryResource{
+			UserID:  *msroomList.GetId(),
+			Email:   email,
+			Purpose: purposeRoomList,
+		}
+		if name := msroomList.GetDisplayName(); name != nil {
+			resource.DisplayName = *name
+		}
+		if err := conf.UpsertDirectoryResource(ctx, config, resource); err != nil {
+			return err
+		}
+		current[resource.UserID] = true
+	}
+
+	stored, err := conf.GetDirectoryResources(ctx, config, purposeRoomList)
+	if err != nil {
+		return fmt.Errorf("getting room lists: %v", err)
+	}
+	for _, resource := range stored {
+		if current[resource.UserID] {
+			continue
+		}
+		log.Debug("microsoft-365", "Room list %s removed from the directory.", resource.Email)
+		if err := conf.DeleteDirectoryResource(ctx, config, resource.UserID); err != nil {
+			return err
+		}
+	}
+
+	rooms, err := conf.GetDirectoryResources(ctx, config, purposeRoom)
+	if err != nil {
+		return fmt.Errorf("getting rooms: %v", err)
+	}
+	for _, resource := range rooms {
+		room, err := roomFromResource(resource)
+		if err != nil {
+			return err
+		}
+		lists := memberships[strings.ToLower(resource.Email)]
+		slices.Sort(lists)
+		if room.RoomLists == strings.Join(lists, ",") {
+			continue
+		}
+		room.RoomLists = strings.Join(lists, ",")
+		marshalled, err := json.Marshal(room)
+		if err != nil {
+			return fmt.Errorf("marshalling room %s: %v", resource.Email, err)
+		}
+		resource.Room = null.JSONFrom(marshalled)
+		if err := conf.UpsertDirectoryResource(ctx, config, resource); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getRoomListMembers returns the lower-cased email addresses of the rooms in the room list.
+func (g *GraphHelper) getRoomListMembers(ctx context.Context, roomListEmail string) ([]string, error) {
+	r, err := g.userClient.Places().ByPlaceId(roomListEmail).GraphRoomList().Rooms().Get(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("querying rooms of room list: %+v", err)
+	}
+	pageIterator, err := msgraphcore.NewPageIterator[*models.Room](
+		r, g.userClient.GetAdapter(), models.CreateRoomCollectionResponseFromDiscriminatorValue,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("getting room iterator: %v", err)
+	}
+	var members []string
+	if err := pageIterator.Iterate(ctx, func(msroom *models.Room) bool {
+		if msroom != nil && msroom.GetEmailAddress() != nil {
+			members = append(members, strings.ToLower(*msroom.GetEmailAddress()))
+		}
+		// Return true to continue the iteration
+		return true
+	}); err != nil {
+		return nil, fmt.Errorf("iterating rooms: %v", err)
+	}
+	return members, nil
+}
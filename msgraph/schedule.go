@@ -0,0 +1,311 @@
+package msgraph
+
+import (
+	"context"
+	"fmt"
+	"microsoft-365/apiserver"
+	"sort"
+	"time"
+
+	"github.com/eliona-smart-building-assistant/go-utils/common"
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+	abstractions "github.com/microsoft/kiota-abstractions-go"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+	"github.com/microsoftgraph/msgraph-sdk-go/users"
+)
+
+// Defaults for configurations that do not specify the look-ahead window and interval, in minutes.
+const (
+	DefaultScheduleWindow   = 60
+	DefaultScheduleInterval = 30
+)
+
+// scheduleOptions defines how the schedules of rooms and equipment are queried.
+type scheduleOptions struct {
+	timeZone TimeZone
+	// window is how far into the future the schedules are queried.
+	window time.Duration
+	// interval is the duration of one slot in the availability view, in minutes.
+	interval int32
+}
+
+func scheduleOptionsFromConfig(config apiserver.Configuration) (scheduleOptions, error) {
+	tz, err := ParseTimeZone(config.TimeZone)
+	if err != nil {
+		return scheduleOptions{}, fmt.Errorf("parsing time zone: %v", err)
+	}
+	window := config.ScheduleWindow
+	if window == 0 {
+		window = DefaultScheduleWindow
+	}
+	interval := config.ScheduleInterval
+	if interval == 0 {
+		interval = DefaultScheduleInterval
+	}
+	return scheduleOptions{
+		timeZone: tz,
+		window:   time.Duration(window) * time.Minute,
+		interval: interval,
+	}, nil
+}
+
+// ValidateScheduleOptions checks the look-ahead window and interval against the limits of the
+// getSchedule endpoint.
+func ValidateScheduleOptions(window, interval int32) error {
+	if interval < 5 || interval > 1440 {
+		return fmt.Errorf("schedule interval must be between 5 and 1440 minutes, is %d", interval)
+	}
+	if window < interval {
+		return fmt.Errorf("schedule window (%d) must not be shorter than the interval (%d)", window, interval)
+	}
+	// Graph limits the queried time range to 62 days.
+	if window > 62*24*60 {
+		return fmt.Errorf("schedule window must not exceed 62 days, is %d minutes", window)
+	}
+	return nil
+}
+
+// busyPeriod is a time span in which a resource is not available.
+type busyPeriod struct {
+	start time.Time
+	end   time.Time
+	item  models.ScheduleItemable
+}
+
+// busyPeriods returns schedule items which block the resource, sorted by their start.
+func busyPeriods(tz TimeZone, items []models.ScheduleItemable) ([]busyPeriod, error) {
+	var periods []busyPeriod
+	for _, item := range items {
+		if status := item.GetStatus(); status != nil && (*status == models.FREE_FREEBUSYSTATUS || *status == models.UNKNOWN_FREEBUSYSTATUS) {
+			continue
+		}
+		if item.GetStart() == nil || item.GetStart().GetDateTime() == nil ||
+			item.GetEnd() == nil || item.GetEnd().GetDateTime() == nil {
+			continue
+		}
+		start, err := tz.parse(*item.GetStart().GetDateTime())
+		if err != nil {
+			return nil, fmt.Errorf("parsing start: %v", err)
+		}
+		end, err := tz.parse(*item.GetEnd().GetDateTime())
+		if err != nil {
+			return nil, fmt.Errorf("parsing end: %v", err)
+		}
+		periods = append(periods, busyPeriod{start: start, end: end, item: item})
+	}
+	sort.Slice(periods, func(i, j int) bool {
+		return periods[i].start.Before(periods[j].start)
+	})
+	return periods, nil
+}
+
+// currentPeriod returns the period taking place at the given time, if any.
+func currentPeriod(periods []busyPeriod, now time.Time) *busyPeriod {
+	for i, p := range periods {
+		if !p.start.After(now) && p.end.After(now) {
+			return &periods[i]
+		}
+	}
+	return nil
+}
+
+// nextFreeAndBusy returns when a busy resource becomes free, or when a free resource becomes
+// busy. Consecutive and overlapping periods are considered one. Times beyond the look-ahead window
+// are unknown and returned as nil.
+func nextFreeAndBusy(periods []busyPeriod, now, windowEnd time.Time) (nextFree, nextBusy *time.Time) {
+	cursor := now
+	busy := false
+	for _, p := range periods {
+		if !p.end.After(cursor) {
+			continue
+		}
+		if p.start.After(cursor) {
+			break
+		}
+		busy = true
+		cursor = p.end
+	}
+	if busy {
+		if cursor.Before(windowEnd) {
+			nextFree = &cursor
+		}
+		return nextFree, nil
+	}
+	for _, p := range periods {
+		if p.start.After(now) {
+			if p.start.Before(windowEnd) {
+				nextBusy = &p.start
+			}
+			break
+		}
+	}
+	return nil, nextBusy
+}
+
+func formatTimestamp(tz TimeZone, t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.In(tz.Location).Format(time.RFC3339)
+	return &s
+}
+
+// nextPeriod returns the first period starting after the given time, if any.
+func nextPeriod(periods []busyPeriod, now time.Time) *busyPeriod {
+	for i, p := range periods {
+		if p.start.After(now) {
+			return &periods[i]
+		}
+	}
+	return nil
+}
+
+// privateDetail replaces the details of meetings marked as private in Exchange.
+const privateDetail = "Private"
+
+// meeting holds the details of a schedule item as written to Eliona.
+type meeting struct {
+	Subject   *string
+	Start     *string
+	End       *string
+	Organizer *string
+	// One of busy, tentative, oof or workingElsewhere.
+	Status    *string
+	IsPrivate *int8
+}
+
+// eventKey identifies an event in the calendar of a resource by its time span, as schedule items
+// do not carry the event ID.
+type eventKey struct {
+	start int64
+	end   int64
+}
+
+type eventDetails struct {
+	organizer *string
+	isPrivate bool
+}
+
+func newMeeting(tz TimeZone, p *busyPeriod, events map[eventKey]eventDetails) meeting {
+	if p == nil {
+		return meeting{}
+	}
+	private := p.item.GetIsPrivate() != nil && *p.item.GetIsPrivate()
+	details, ok := events[eventKey{start: p.start.Unix(), end: p.end.Unix()}]
+	if ok && details.isPrivate {
+		private = true
+	}
+
+	m := meeting{
+		Start:     formatTimestamp(tz, &p.start),
+		End:       formatTimestamp(tz, &p.end),
+		IsPrivate: new(int8),
+	}
+	if status := p.item.GetStatus(); status != nil {
+		s := status.String()
+		m.Status = &s
+	}
+	if private {
+		*m.IsPrivate = 1
+		m.Subject = common.Ptr(privateDetail)
+		m.Organizer = common.Ptr(privateDetail)
+		return m
+	}
+	m.Subject = p.item.GetSubject()
+	if ok {
+		m.Organizer = details.organizer
+	}
+	return m
+}
+
+// calendarViewPageSize is the number of events requested per page of a calendar view. Graph
+// returns only 10 by default.
+const calendarViewPageSize = 100
+
+// meetingLookup asks for the details of the current and next meeting of a resource, which lie
+// between from and to.
+type meetingLookup struct {
+	email   string
+	from    time.Time
+	to      time.Time
+	current *busyPeriod
+	next    *busyPeriod
+}
+
+// fetchEventDetails reads the organizers of events in the calendars of the resources, by email
+// address. The schedule does not contain them.
+//
+// Only details visible to the app are returned, so reading them needs the Calendars.Read
+// permission for the resource. As the organizers are optional, resources whose calendar cannot be
+// read are logged and left out.
+func (g *GraphHelper) fetchEventDetails(ctx context.Context, tz TimeZone, lookups []meetingLookup) map[string]map[eventKey]eventDetails {
+	var requests []calendarViewRequest
+	for _, l := range lookups {
+		requests = append(requests, calendarViewRequest{email: l.email, from: l.from, to: l.to})
+	}
+	calendars, failed := g.fetchCalendarViews(ctx, tz, requests, []string{"start", "end", "organizer", "sensitivity"})
+	for email, err := range failed {
+		log.Debug("microsoft-365", "fetching meeting details of %s: %v", email, err)
+	}
+	events := make(map[string]map[eventKey]eventDetails)
+	for email, calendar := range calendars {
+		details := make(map[eventKey]eventDetails)
+		if err := addEventDetails(tz, calendar, details); err != nil {
+			log.Debug("microsoft-365", "reading meeting details of %s: %v", email, err)
+			continue
+		}
+		events[email] = details
+	}
+	return events
+}
+
+func calendarViewConfiguration(tz TimeZone, from, to time.Time, fields []string) *users.ItemCalendarViewRequestBuilderGetRequestConfiguration {
+	start := from.Format(time.RFC3339)
+	end := to.Format(time.RFC3339)
+	top := int32(calendarViewPageSize)
+	return &users.ItemCalendarViewRequestBuilderGetRequestConfiguration{
+		Headers: preferTimeZone(tz),
+		QueryParameters: &users.ItemCalendarViewRequestBuilderGetQueryParameters{
+			StartDateTime: &start,
+			EndDateTime:   &end,
+			Select:        fields,
+			Top:           &top,
+		},
+	}
+}
+
+func preferTimeZone(tz TimeZone) *abstractions.RequestHeaders {
+	headers := abstractions.NewRequestHeaders()
+	headers.Add("Prefer", tz.preferHeader())
+	return headers
+}
+
+// addEventDetails adds the details of the events to details, by their time span.
+func addEventDetails(tz TimeZone, events []models.Eventable, details map[eventKey]eventDetails) error {
+	for _, event := range events {
+		if event.GetStart() == nil || event.GetStart().GetDateTime() == nil ||
+			event.GetEnd() == nil || event.GetEnd().GetDateTime() == nil {
+			continue
+		}
+		eventStart, err := tz.parse(*event.GetStart().GetDateTime())
+		if err != nil {
+			return fmt.Errorf("parsing start: %v", err)
+		}
+		eventEnd, err := tz.parse(*event.GetEnd().GetDateTime())
+		if err != nil {
+			return fmt.Errorf("parsing end: %v", err)
+		}
+		d := eventDetails{}
+		if s := event.GetSensitivity(); s != nil && (*s == models.PRIVATE_SENSITIVITY || *s == models.CONFIDENTIAL_SENSITIVITY) {
+			d.isPrivate = true
+		}
+		if o := event.GetOrganizer(); o != nil && o.GetEmailAddress() != nil {
+			d.organizer = o.GetEmailAddress().GetName()
+			if d.organizer == nil || *d.organizer == "" {
+				d.organizer = o.GetEmailAddress().GetAddress()
+			}
+		}
+		details[eventKey{start: eventStart.Unix(), end: eventEnd.Unix()}] = d
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package msgraph
+
+import (
+	"testing"
+	"time"
+
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+)
+
+func scheduleItem(start, end string, status models.FreeBusyStatus) models.ScheduleItemable {
+	item := models.NewScheduleItem()
+	itemStart := models.NewDateTimeTimeZone()
+	itemStart.SetDateTime(&start)
+	item.SetStart(itemStart)
+	itemEnd := models.NewDateTimeTimeZone()
+	itemEnd.SetDateTime(&end)
+	item.SetEnd(itemEnd)
+	item.SetStatus(&status)
+	return item
+}
+
+func testTimeZone(t *testing.T) TimeZone {
+	t.Helper()
+	tz, err := ParseTimeZone("Europe/Zurich")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tz
+}
+
+func at(tz TimeZone, hour, minute int) time.Time {
+	return time.Date(2024, 7, 1, hour, minute, 0, 0, tz.Location)
+}
+
+func period(tz TimeZone, startHour, startMinute, endHour, endMinute int) busyPeriod {
+	return busyPeriod{start: at(tz, startHour, startMinute), end: at(tz, endHour, endMinute)}
+}
+
+func TestBusyPeriods(t *testing.T) {
+	tz := testTimeZone(t)
+	items := []models.ScheduleItemable{
+		scheduleItem("2024-07-01T11:00:00.0000000", "2024-07-01T12:00:00.0000000", models.TENTATIVE_FREEBUSYSTATUS),
+		scheduleItem("2024-07-01T08:00:00.0000000", "2024-07-01T09:00:00.0000000", models.FREE_FREEBUSYSTATUS),
+		scheduleItem("2024-07-01T09:00:00.0000000", "2024-07-01T10:00:00.0000000", models.BUSY_FREEBUSYSTATUS),
+		scheduleItem("2024-07-01T13:00:00.0000000", "2024-07-01T14:00:00.0000000", models.UNKNOWN_FREEBUSYSTATUS),
+		scheduleItem("2024-07-01T15:00:00.0000000", "2024-07-01T16:00:00.0000000", models.OOF_FREEBUSYSTATUS),
+	}
+	periods, err := busyPeriods(tz, items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []busyPeriod{period(tz, 9, 0, 10, 0), period(tz, 11, 0, 12, 0), period(tz, 15, 0, 16, 0)}
+	if len(periods) != len(want) {
+		t.Fatalf("got %d periods, want %d", len(periods), len(want))
+	}
+	for i, p := range periods {
+		if !p.start.Equal(want[i].start) || !p.end.Equal(want[i].end) {
+			t.Errorf("period %d is %v - %v, want %v - %v", i, p.start, p.end, want[i].start, want[i].end)
+		}
+	}
+}
+
+func TestBusyPeriodsInvalidTime(t *testing.T) {
+	tz := testTimeZone(t)
+	items := []models.ScheduleItemable{
+		scheduleItem("tomorrow", "2024-07-01T12:00:00.0000000", models.BUSY_FREEBUSYSTATUS),
+	}
+	if _, err := busyPeriods(tz, items); err == nil {
+		t.Error("busyPeriods succeeded, want error")
+	}
+}
+
+func TestCurrentPeriod(t *testing.T) {
+	tz := testTimeZone(t)
+	periods := []busyPeriod{period(tz, 9, 0, 10, 0), period(tz, 11, 0, 12, 0)}
+	tests := []struct {
+		now  time.Time
+		want *busyPeriod
+	}{
+		{at(tz, 8, 59), nil},
+		{at(tz, 9, 0), &periods[0]},
+		{at(tz, 9, 59), &periods[0]},
+		{at(tz, 10, 0), nil},
+		{at(tz, 11, 30), &periods[1]},
+	}
+	for _, tt := range tests {
+		if got := currentPeriod(periods, tt.now); got != tt.want {
+			t.Errorf("currentPeriod at %v = %v, want %v", tt.now, got, tt.want)
+		}
+	}
+}
+
+func TestNextFreeAndBusy(t *testing.T) {
+	tz := testTimeZone(t)
+	periods := []busyPeriod{
+		period(tz, 9, 0, 10, 0),
+		// Overlapping and consecutive periods count as one.
+		period(tz, 9, 30, 10, 30),
+		period(tz, 10, 30, 11, 0),
+		period(tz, 13, 0, 14, 0),
+	}
+	tests := []struct {
+		name      string
+		now       time.Time
+		windowEnd time.Time
+		nextFree  *time.Time
+		nextBusy  *time.Time
+	}{
+		{"free", at(tz, 8, 0), at(tz, 12, 0), nil, ptr(at(tz, 9, 0))},
+		{"busy", at(tz, 9, 15), at(tz, 12, 0), ptr(at(tz, 11, 0)), nil},
+		{"free between periods", at(tz, 11, 0), at(tz, 14, 0), nil, ptr(at(tz, 13, 0))},
+		{"busy beyond window", at(tz, 9, 15), at(tz, 10, 0), nil, nil},
+		{"free beyond window", at(tz, 11, 0), at(tz, 12, 0), nil, nil},
+		{"free after last period", at(tz, 15, 0), at(tz, 18, 0), nil, nil},
+	}
+	for _, tt := range tests {
+		nextFree, nextBusy := nextFreeAndBusy(periods, tt.now, tt.windowEnd)
+		if !equalTimes(nextFree, tt.nextFree) || !equalTimes(nextBusy, tt.nextBusy) {
+			t.Errorf("%s: got next free %v and next busy %v, want %v and %v", tt.name, nextFree, nextBusy, tt.nextFree, tt.nextBusy)
+		}
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func equalTimes(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
@@ -0,0 +1,150 @@
+package msgraph
+
+import (
+	"context"
+	"fmt"
+	"microsoft-365/apiserver"
+	"microsoft-365/conf"
+	"time"
+
+	azcore "github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/AzureAD/microsoft-authentication-library-for-go/apps/cache"
+	"github.com/AzureAD/microsoft-authentication-library-for-go/apps/public"
+)
+
+// sessionScopes request the delegated permissions granted to the app. MSAL adds the scopes for
+// the ID and refresh tokens by itself.
+var sessionScopes = []string{"https://graph.microsoft.com/.default"}
+
+// DeviceCodeLogin is a sign-in of a user with a device code, started for a booking session.
+type DeviceCodeLogin struct {
+	UserCode string
+	// VerificationURL is where the user signs in with the code.
+	VerificationURL string
+	ExpiresOn       time.Time
+	deviceCode      public.DeviceCode
+}
+
+// SignedInUser identifies the user who signed in with a device code.
+type SignedInUser struct {
+	UserName    string
+	DisplayName string
+}
+
+// StartDeviceCodeLogin requests a device code for a user to sign in with. The tokens of the user
+// are kept in the booking session stored under the user code, so the session has to be stored
+// before waiting for the sign-in.
+//
+// IMPORTANT: Needs "Allow public client flows" in Entra[1].
+// [1]https://github.com/microsoftgraph/msgraph-sdk-python-core/issues/153#issuecomment-1785682746
+func StartDeviceCodeLogin(ctx context.Context, clientID, tenantID string) (*DeviceCodeLogin, error) {
+	tokenCache := &sessionTokenCache{}
+	client, err := newSessionClient(clientID, tenantID, tokenCache)
+	if err != nil {
+		return nil, err
+	}
+	deviceCode, err := client.AcquireTokenByDeviceCode(ctx, sessionScopes)
+	if err != nil {
+		return nil, fmt.Errorf("requesting device code: %v", err)
+	}
+	tokenCache.userCode = deviceCode.Result.UserCode
+	return &DeviceCodeLogin{
+		UserCode:        deviceCode.Result.UserCode,
+		VerificationURL: deviceCode.Result.VerificationURL,
+		ExpiresOn:       deviceCode.Result.ExpiresOn,
+		deviceCode:      deviceCode,
+	}, nil
+}
+
+// Wait blocks until the user signed in with the device code, refused to, or the code expired.
+func (l *DeviceCodeLogin) Wait(ctx context.Context) (SignedInUser, error) {
+	result, err := l.deviceCode.AuthenticationResult(ctx)
+	if err != nil {
+		return SignedInUser{}, err
+	}
+	return SignedInUser{
+		UserName:    result.Account.PreferredUsername,
+		DisplayName: result.IDToken.Name,
+	}, nil
+}
+
+// NewSessionGraph returns a client acting on behalf of the user who signed in with the device
+// code of a booking session.
+func NewSessionGraph(config apiserver.Configuration, userCode string) (*GraphHelper, error) {
+	client, err := newSessionClient(config.ClientId, config.TenantId, &sessionTokenCache{userCode: userCode})
+	if err != nil {
+		return nil, err
+	}
+	g := NewGraphHelper(*config.Id)
+	g.credential = &sessionCredential{client: client}
+	g.isDelegated = true
+	if err := g.completeAuth(); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+func newSessionClient(clientID, tenantID string, tokenCache cache.ExportReplace) (public.Client, error) {
+	client, err := public.New(clientID,
+		public.WithAuthority("https://login.microsoftonline.com/"+tenantID),
+		public.WithCache(tokenCache),
+	)
+	if err != nil {
+		return public.Client{}, fmt.Errorf("creating public client: %v", err)
+	}
+	return client, nil
+}
+
+// sessionCredential acquires tokens silently from the token cache of a booking session, refreshing
+// them as needed.
+type sessionCredential struct {
+	client public.Client
+}
+
+func (c *sessionCredential) GetToken(ctx context.Context, options policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	accounts, err := c.client.Accounts(ctx)
+	if err != nil {
+		return azcore.AccessToken{}, fmt.Errorf("reading accounts: %v", err)
+	}
+	if len(accounts) == 0 {
+		return azcore.AccessToken{}, fmt.Errorf("no user signed in with the device code")
+	}
+	result, err := c.client.AcquireTokenSilent(ctx, options.Scopes, public.WithSilentAccount(accounts[0]))
+	if err != nil {
+		return azcore.AccessToken{}, fmt.Errorf("acquiring token: %v", err)
+	}
+	return azcore.AccessToken{Token: result.AccessToken, ExpiresOn: result.ExpiresOn}, nil
+}
+
+// sessionTokenCache keeps the MSAL token cache in the database, so that booking sessions survive
+// restarts and are shared by all instances of the app.
+type sessionTokenCache struct {
+	userCode string
+}
+
+func (c *sessionTokenCache) Replace(ctx context.Context, u cache.Unmarshaler, _ cache.ReplaceHints) error {
+	if c.userCode == "" {
+		return nil
+	}
+	data, err := conf.GetBookingSessionTokenCache(ctx, c.userCode)
+	if err != nil {
+		return err
+	}
+	if data == nil {
+		// Clears the tokens of revoked sessions from memory.
+		data = []byte("{}")
+	}
+	return u.Unmarshal(data)
+}
+
+func (c *sessionTokenCache) Export(ctx context.Context, m cache.Marshaler, _ cache.ExportHints) error {
+	if c.userCode == "" {
+		return nil
+	}
+	data, err := m.Marshal()
+	if err != nil {
+		return fmt.Errorf("marshalling token cache: %v", err)
+	}
+	return conf.SetBookingSessionTokenCache(ctx, c.userCode, data)
+}
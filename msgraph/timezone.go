@@ -0,0 +1,355 @@
+package msgraph
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	// The target image is not guaranteed to ship the IANA database.
+	_ "time/tzdata"
+)
+
+// DefaultTimeZone is used for configurations that do not specify any time zone. It corresponds to
+// the time zone the app used before it was configurable.
+const DefaultTimeZone = "W. Europe Standard Time"
+
+// TimeZone holds both representations of a time zone. Microsoft Graph expects Windows time zone
+// names, while Go needs the IANA location to do any calculations.
+type TimeZone struct {
+	Windows  string
+	Location *time.Location
+}
+
+// graphDateTimeLayout is the format Microsoft Graph uses in dateTimeTimeZone resources.
+//
+// The docs say "2006-01-02T15:04:05" is the correct format, but RFC3339 is acceptable as well
+// (but undocumented). Responses contain up to seven fractional digits.
+const graphDateTimeLayout = "2006-01-02T15:04:05"
+
+// ParseTimeZone accepts either IANA (e.g. "Europe/Zurich") or Windows (e.g. "W. Europe Standard
+// Time") time zone name and returns both representations. IANA locations that are not listed in
+// the mapping get the Windows time zone with the same offsets.
+func ParseTimeZone(name string) (TimeZone, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = DefaultTimeZone
+	}
+
+	if iana, ok := windowsToIANA[name]; ok {
+		loc, err := time.LoadLocation(iana)
+		if err != nil {
+			return TimeZone{}, fmt.Errorf("loading location %s for time zone %s: %v", iana, name, err)
+		}
+		return TimeZone{Windows: name, Location: loc}, nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return TimeZone{}, fmt.Errorf("unknown time zone %s: %v", name, err)
+	}
+	windows, ok := ianaToWindows[loc.String()]
+	if !ok {
+		windows, ok = matchWindowsTimeZone(loc)
+	}
+	if !ok {
+		return TimeZone{}, fmt.Errorf("no Windows time zone known for %s", name)
+	}
+	return TimeZone{Windows: windows, Location: loc}, nil
+}
+
+// matchedWindowsTimeZones caches the results of matchWindowsTimeZone by location name.
+var matchedWindowsTimeZones sync.Map
+
+// matchWindowsTimeZone finds the Windows time zone whose primary location has the same UTC offsets
+// as the location during the coming year, i.e. the same standard time and daylight saving time
+// rules. If several do, the first by name is used, as they are interchangeable for Graph.
+func matchWindowsTimeZone(loc *time.Location) (string, bool) {
+	if cached, ok := matchedWindowsTimeZones.Load(loc.String()); ok {
+		windows := cached.(string)
+		return windows, windows != ""
+	}
+	start := time.Now().UTC().Truncate(time.Hour)
+	end := start.AddDate(1, 0, 0)
+	names := make([]string, 0, len(windowsToIANA))
+	for windows := range windowsToIANA {
+		names = append(names, windows)
+	}
+	slices.Sort(names)
+
+	match := ""
+	for _, windows := range names {
+		candidate, err := time.LoadLocation(windowsToIANA[windows])
+		if err != nil {
+			continue
+		}
+		same := true
+		// Transitions happen on the hour or half hour, so they differ at some sample if the rules do.
+		for t := start; same && t.Before(end); t = t.Add(30 * time.Minute) {
+			_, offset := t.In(loc).Zone()
+			_, candidateOffset := t.In(candidate).Zone()
+			same = offset == candidateOffset
+		}
+		if same {
+			match = windows
+			break
+		}
+	}
+	matchedWindowsTimeZones.Store(loc.String(), match)
+	return match, match != ""
+}
+
+// ValidateTimeZone checks that the time zone name is usable by the app.
+func ValidateTimeZone(name string) error {
+	_, err := ParseTimeZone(name)
+	return err
+}
+
+// format returns the time as a local time in the time zone, as expected by Graph.
+func (tz TimeZone) format(t time.Time) string {
+	return t.In(tz.Location).Format(graphDateTimeLayout)
+}
+
+// parse reads the local time returned by Graph in this time zone.
+func (tz TimeZone) parse(s string) (time.Time, error) {
+	return time.ParseInLocation("2006-01-02T15:04:05.9999999", s, tz.Location)
+}
+
+// parseInput reads a time passed to the app API. Times with an offset (ISO 8601) are converted
+// to the time zone, times without one are considered to be local in it.
+func (tz TimeZone) parseInput(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return t.In(tz.Location), nil
+	}
+	t, err := tz.parse(s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parsing datetime %s: %v", s, err)
+	}
+	return t, nil
+}
+
+// preferHeader returns the value for the "Prefer" header that makes Graph respond in this time
+// zone. If not specified, values returned are in UTC.
+func (tz TimeZone) preferHeader() string {
+	return fmt.Sprintf("outlook.timezone=\"%s\"", tz.Windows)
+}
+
+var ianaToWindows = func() map[string]string {
+	m := make(map[string]string, len(windowsToIANA)+len(ianaAliases))
+	for windows, iana := range windowsToIANA {
+		m[iana] = windows
+	}
+	for iana, windows := range ianaAliases {
+		m[iana] = windows
+	}
+	return m
+}()
+
+// windowsToIANA maps Windows time zones to their primary IANA location as defined by CLDR
+// (https://github.com/unicode-org/cldr/blob/main/common/supplemental/windowsZones.xml).
+var windowsToIANA = map[string]string{
+	"Dateline Standard Time":          "Etc/GMT+12",
+	"UTC-11":                          "Etc/GMT+11",
+	"Aleutian Standard Time":          "America/Adak",
+	"Hawaiian Standard Time":          "Pacific/Honolulu",
+	"Marquesas Standard Time":         "Pacific/Marquesas",
+	"Alaskan Standard Time":           "America/Anchorage",
+	"UTC-09":                          "Etc/GMT+9",
+	"Pacific Standard Time (Mexico)":  "America/Tijuana",
+	"UTC-08":                          "Etc/GMT+8",
+	"Pacific Standard Time":           "America/Los_Angeles",
+	"US Mountain Standard Time":       "America/Phoenix",
+	"Mountain Standard Time (Mexico)": "America/Mazatlan",
+	"Mountain Standard Time":          "America/Denver",
+	"Yukon Standard Time":             "America/Whitehorse",
+	"Central America Standard Time":   "America/Guatemala",
+	"Central Standard Time":           "America/Chicago",
+	"Easter Island Standard Time":     "Pacific/Easter",
+	"Central Standard Time (Mexico)":  "America/Mexico_City",
+	"Canada Central Standard Time":    "America/Regina",
+	"SA Pacific Standard Time":        "America/Bogota",
+	"Eastern Standard Time (Mexico)":  "America/Cancun",
+	"Eastern Standard Time":           "America/New_York",
+	"Haiti Standard Time":             "America/Port-au-Prince",
+	"Cuba Standard Time":              "America/Havana",
+	"US Eastern Standard Time":        "America/Indianapolis",
+	"Turks And Caicos Standard Time":  "America/Grand_Turk",
+	"Paraguay Standard Time":          "America/Asuncion",
+	"Atlantic Standard Time":          "America/Halifax",
+	"Venezuela Standard Time":         "America/Caracas",
+	"Central Brazilian Standard Time": "America/Cuiaba",
+	"SA Western Standard Time":        "America/La_Paz",
+	"Pacific SA Standard Time":        "America/Santiago",
+	"Newfoundland Standard Time":      "America/St_Johns",
+	"Tocantins Standard Time":         "America/Araguaina",
+	"E. South America Standard Time":  "America/Sao_Paulo",
+	"SA Eastern Standard Time":        "America/Cayenne",
+	"Argentina Standard Time":         "America/Buenos_Aires",
+	"Greenland Standard Time":         "America/Godthab",
+	"Montevideo Standard Time":        "America/Montevideo",
+	"Magallanes Standard Time":        "America/Punta_Arenas",
+	"Saint Pierre Standard Time":      "America/Miquelon",
+	"Bahia Standard Time":             "America/Bahia",
+	"UTC-02":                          "Etc/GMT+2",
+	"Azores Standard Time":            "Atlantic/Azores",
+	"Cape Verde Standard Time":        "Atlantic/Cape_Verde",
+	"UTC":                             "Etc/UTC",
+	"GMT Standard Time":               "Europe/London",
+	"Greenwich Standard Time":         "Atlantic/Reykjavik",
+	"Sao Tome Standard Time":          "Africa/Sao_Tome",
+	"Morocco Standard Time":           "Africa/Casablanca",
+	"W. Europe Standard Time":         "Europe/Berlin",
+	"Central Europe Standard Time":    "Europe/Budapest",
+	"Romance Standard Time":           "Europe/Paris",
+	"Central European Standard Time":  "Europe/Warsaw",
+	"W. Central Africa Standard Time": "Africa/Lagos",
+	"Jordan Standard Time":            "Asia/Amman",
+	"GTB Standard Time":               "Europe/Bucharest",
+	"Middle East Standard Time":       "Asia/Beirut",
+	"Egypt Standard Time":             "Africa/Cairo",
+	"E. Europe Standard Time":         "Europe/Chisinau",
+	"Syria Standard Time":             "Asia/Damascus",
+	"West Bank Standard Time":         "Asia/Hebron",
+	"South Africa Standard Time":      "Africa/Johannesburg",
+	"FLE Standard Time":               "Europe/Kiev",
+	"Israel Standard Time":            "Asia/Jerusalem",
+	"South Sudan Standard Time":       "Africa/Juba",
+	"Kaliningrad Standard Time":       "Europe/Kaliningrad",
+	"Sudan Standard Time":             "Africa/Khartoum",
+	"Libya Standard Time":             "Africa/Tripoli",
+	"Namibia Standard Time":           "Africa/Windhoek",
+	"Arabic Standard Time":            "Asia/Baghdad",
+	"Turkey Standard Time":            "Europe/Istanbul",
+	"Arab Standard Time":              "Asia/Riyadh",
+	"Belarus Standard Time":           "Europe/Minsk",
+	"Russian Standard Time":           "Europe/Moscow",
+	"E. Africa Standard Time":         "Africa/Nairobi",
+	"Volgograd Standard Time":         "Europe/Volgograd",
+	"Iran Standard Time":              "Asia/Tehran",
+	"Arabian Standard Time":           "Asia/Dubai",
+	"Astrakhan Standard Time":         "Europe/Astrakhan",
+	"Azerbaijan Standard Time":        "Asia/Baku",
+	"Russia Time Zone 3":              "Europe/Samara",
+	"Mauritius Standard Time":         "Indian/Mauritius",
+	"Saratov Standard Time":           "Europe/Saratov",
+	"Georgian Standard Time":          "Asia/Tbilisi",
+	"Caucasus Standard Time":          "Asia/Yerevan",
+	"Afghanistan Standard Time":       "Asia/Kabul",
+	"West Asia Standard Time":         "Asia/Tashkent",
+	"Ekaterinburg Standard Time":      "Asia/Yekaterinburg",
+	"Pakistan Standard Time":          "Asia/Karachi",
+	"Qyzylorda Standard Time":         "Asia/Qyzylorda",
+	"India Standard Time":             "Asia/Calcutta",
+	"Sri Lanka Standard Time":         "Asia/Colombo",
+	"Nepal Standard Time":             "Asia/Katmandu",
+	"Central Asia Standard Time":      "Asia/Bishkek",
+	"Bangladesh Standard Time":        "Asia/Dhaka",
+	"Omsk Standard Time":              "Asia/Omsk",
+	"Myanmar Standard Time":           "Asia/Rangoon",
+	"SE Asia Standard Time":           "Asia/Bangkok",
+	"Altai Standard Time":             "Asia/Barnaul",
+	"W. Mongolia Standard Time":       "Asia/Hovd",
+	"North Asia Standard Time":        "Asia/Krasnoyarsk",
+	"N. Central Asia Standard Time":   "Asia/Novosibirsk",
+	"Tomsk Standard Time":             "Asia/Tomsk",
+	"China Standard Time":             "Asia/Shanghai",
+	"North Asia East Standard Time":   "Asia/Irkutsk",
+	"Singapore Standard Time":         "Asia/Singapore",
+	"W. Australia Standard Time":      "Australia/Perth",
+	"Taipei Standard Time":            "Asia/Taipei",
+	"Ulaanbaatar Standard Time":       "Asia/Ulaanbaatar",
+	"Aus Central W. Standard Time":    "Australia/Eucla",
+	"Transbaikal Standard Time":       "Asia/Chita",
+	"Tokyo Standard Time":             "Asia/Tokyo",
+	"North Korea Standard Time":       "Asia/Pyongyang",
+	"Korea Standard Time":             "Asia/Seoul",
+	"Yakutsk Standard Time":           "Asia/Yakutsk",
+	"Cen. Australia Standard Time":    "Australia/Adelaide",
+	"AUS Central Standard Time":       "Australia/Darwin",
+	"E. Australia Standard Time":      "Australia/Brisbane",
+	"AUS Eastern Standard Time":       "Australia/Sydney",
+	"West Pacific Standard Time":      "Pacific/Port_Moresby",
+	"Tasmania Standard Time":          "Australia/Hobart",
+	"Vladivostok Standard Time":       "Asia/Vladivostok",
+	"Lord Howe Standard Time":         "Australia/Lord_Howe",
+	"Bougainville Standard Time":      "Pacific/Bougainville",
+	"Russia Time Zone 10":             "Asia/Srednekolymsk",
+	"Magadan Standard Time":           "Asia/Magadan",
+	"Norfolk Standard Time":           "Pacific/Norfolk",
+	"Sakhalin Standard Time":          "Asia/Sakhalin",
+	"Central Pacific Standard Time":   "Pacific/Guadalcanal",
+	"Russia Time Zone 11":             "Asia/Kamchatka",
+	"New Zealand Standard Time":       "Pacific/Auckland",
+	"UTC+12":                          "Etc/GMT-12",
+	"Fiji Standard Time":              "Pacific/Fiji",
+	"Chatham Islands Standard Time":   "Pacific/Chatham",
+	"UTC+13":                          "Etc/GMT-13",
+	"Tonga Standard Time":             "Pacific/Tongatapu",
+	"Samoa Standard Time":             "Pacific/Apia",
+	"Line Islands Standard Time":      "Pacific/Kiritimati",
+}
+
+// ianaAliases maps common IANA locations which are not the primary location of any Windows time
+// zone to the one they belong to. Other locations are matched by matchWindowsTimeZone.
+var ianaAliases = map[string]string{
+	"UTC":                            "UTC",
+	"Etc/GMT":                        "UTC",
+	"Europe/Zurich":                  "W. Europe Standard Time",
+	"Europe/Vienna":                  "W. Europe Standard Time",
+	"Europe/Rome":                    "W. Europe Standard Time",
+	"Europe/Amsterdam":               "W. Europe Standard Time",
+	"Europe/Stockholm":               "W. Europe Standard Time",
+	"Europe/Oslo":                    "W. Europe Standard Time",
+	"Europe/Luxembourg":              "W. Europe Standard Time",
+	"Europe/Monaco":                  "W. Europe Standard Time",
+	"Europe/Vaduz":                   "W. Europe Standard Time",
+	"Europe/Malta":                   "W. Europe Standard Time",
+	"Europe/Andorra":                 "W. Europe Standard Time",
+	"Europe/San_Marino":              "W. Europe Standard Time",
+	"Europe/Vatican":                 "W. Europe Standard Time",
+	"Europe/Gibraltar":               "W. Europe Standard Time",
+	"Europe/Busingen":                "W. Europe Standard Time",
+	"Europe/Brussels":                "Romance Standard Time",
+	"Europe/Copenhagen":              "Romance Standard Time",
+	"Europe/Madrid":                  "Romance Standard Time",
+	"Europe/Prague":                  "Central Europe Standard Time",
+	"Europe/Bratislava":              "Central Europe Standard Time",
+	"Europe/Ljubljana":               "Central Europe Standard Time",
+	"Europe/Belgrade":                "Central Europe Standard Time",
+	"Europe/Tirane":                  "Central Europe Standard Time",
+	"Europe/Zagreb":                  "Central European Standard Time",
+	"Europe/Sarajevo":                "Central European Standard Time",
+	"Europe/Skopje":                  "Central European Standard Time",
+	"Europe/Dublin":                  "GMT Standard Time",
+	"Europe/Lisbon":                  "GMT Standard Time",
+	"Atlantic/Canary":                "GMT Standard Time",
+	"Europe/Athens":                  "GTB Standard Time",
+	"Europe/Kyiv":                    "FLE Standard Time",
+	"Europe/Helsinki":                "FLE Standard Time",
+	"Europe/Riga":                    "FLE Standard Time",
+	"Europe/Tallinn":                 "FLE Standard Time",
+	"Europe/Vilnius":                 "FLE Standard Time",
+	"Europe/Sofia":                   "FLE Standard Time",
+	"America/Toronto":                "Eastern Standard Time",
+	"America/Detroit":                "Eastern Standard Time",
+	"America/Indiana/Indianapolis":   "US Eastern Standard Time",
+	"America/Winnipeg":               "Central Standard Time",
+	"America/Edmonton":               "Mountain Standard Time",
+	"America/Vancouver":              "Pacific Standard Time",
+	"America/Argentina/Buenos_Aires": "Argentina Standard Time",
+	"America/Nuuk":                   "Greenland Standard Time",
+	"Asia/Kolkata":                   "India Standard Time",
+	"Asia/Kathmandu":                 "Nepal Standard Time",
+	"Asia/Yangon":                    "Myanmar Standard Time",
+	"Asia/Ho_Chi_Minh":               "SE Asia Standard Time",
+	"Asia/Jakarta":                   "SE Asia Standard Time",
+	"Asia/Hong_Kong":                 "China Standard Time",
+	"Asia/Kuala_Lumpur":              "Singapore Standard Time",
+	"Asia/Manila":                    "Singapore Standard Time",
+	"Asia/Qatar":                     "Arab Standard Time",
+	"Asia/Kuwait":                    "Arab Standard Time",
+	"Asia/Tel_Aviv":                  "Israel Standard Time",
+	"Australia/Melbourne":            "AUS Eastern Standard Time",
+	"Australia/Canberra":             "AUS Eastern Standard Time",
+}
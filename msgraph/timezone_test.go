@@ -0,0 +1,61 @@
+package msgraph
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTimeZone(t *testing.T) {
+	tests := []struct {
+		name     string
+		windows  string
+		location string
+	}{
+		{"", "W. Europe Standard Time", "Europe/Berlin"},
+		{"Europe/Zurich", "W. Europe Standard Time", "Europe/Zurich"},
+		{"W. Europe Standard Time", "W. Europe Standard Time", "Europe/Berlin"},
+		{" Pacific Standard Time ", "Pacific Standard Time", "America/Los_Angeles"},
+		{"America/New_York", "Eastern Standard Time", "America/New_York"},
+		{"UTC", "UTC", "Etc/UTC"},
+		// Not in the mapping, matched by their offsets.
+		{"America/Montreal", "Eastern Standard Time", "America/Montreal"},
+		{"Africa/Algiers", "W. Central Africa Standard Time", "Africa/Algiers"},
+	}
+	for _, tt := range tests {
+		tz, err := ParseTimeZone(tt.name)
+		if err != nil {
+			t.Errorf("ParseTimeZone(%q): %v", tt.name, err)
+			continue
+		}
+		if tz.Windows != tt.windows || tz.Location.String() != tt.location {
+			t.Errorf("ParseTimeZone(%q) = %s, %s, want %s, %s", tt.name, tz.Windows, tz.Location, tt.windows, tt.location)
+		}
+	}
+}
+
+func TestParseTimeZoneUnknown(t *testing.T) {
+	for _, name := range []string{"Mars/Olympus_Mons", "Central Mars Time"} {
+		if _, err := ParseTimeZone(name); err == nil {
+			t.Errorf("ParseTimeZone(%q) succeeded, want error", name)
+		}
+	}
+}
+
+func TestTimeZoneParseAndFormat(t *testing.T) {
+	tz, err := ParseTimeZone("Europe/Zurich")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Graph returns up to seven fractional digits.
+	parsed, err := tz.parse("2024-07-01T09:30:00.0000000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := time.Date(2024, 7, 1, 7, 30, 0, 0, time.UTC)
+	if !parsed.Equal(want) {
+		t.Errorf("parse = %v, want %v", parsed, want)
+	}
+	if formatted := tz.format(want); formatted != "2024-07-01T09:30:00" {
+		t.Errorf("format = %s, want 2024-07-01T09:30:00", formatted)
+	}
+}
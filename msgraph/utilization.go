@@ -0,0 +1,458 @@
+package msgraph
+
+import (
+	"context"
+	"fmt"
+	"microsoft-365/apiserver"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/eliona-smart-building-assistant/go-utils/log"
+	"github.com/microsoftgraph/msgraph-sdk-go/models"
+)
+
+// Defaults for configurations that do not specify the working hours.
+const (
+	DefaultWorkingHoursStart = "08:00"
+	DefaultWorkingHoursEnd   = "18:00"
+)
+
+var DefaultWorkingDays = []string{"monday", "tuesday", "wednesday", "thursday", "friday"}
+
+var weekdays = map[string]time.Weekday{
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+	"sunday":    time.Sunday,
+}
+
+// utilizationInterval defines how often the utilization is calculated. It needs the calendars of
+// all rooms and equipment for the whole week, which is too expensive for every refresh.
+const utilizationInterval = 15 * time.Minute
+
+// utilizationCalculatedAt remembers when the utilization was last calculated, by configuration ID.
+var utilizationCalculatedAt sync.Map
+
+// weekBookingsCache keeps the bookings of the current week by configuration ID. The week is read
+// once a day, later calculations of the same day read only the bookings of the day again.
+var weekBookingsCache sync.Map // map[int64]*weekBookings
+
+type weekBookings struct {
+	// day is the start of the day on which the week was read.
+	day time.Time
+	// bookings by email address of the resource
+	bookings map[string][]booking
+}
+
+// unreadableCalendars remembers by configuration ID the resources whose calendar could not be read
+// for the utilization, along with the error. See UnreadableCalendars.
+var unreadableCalendars sync.Map // map[int64]map[string]string
+
+// UnreadableCalendars returns the resources whose calendar could not be read in the last
+// calculation of the utilization of the configuration, along with the error.
+func UnreadableCalendars(configID int64) map[string]string {
+	failed, ok := unreadableCalendars.Load(configID)
+	if !ok {
+		return nil
+	}
+	return failed.(map[string]string)
+}
+
+// workingHours defines the time against which the utilization is calculated.
+type workingHours struct {
+	// start and end are the offsets from midnight.
+	start time.Duration
+	end   time.Duration
+	days  map[time.Weekday]bool
+}
+
+func parseWorkingHours(start, end string, days []string) (workingHours, error) {
+	hours := workingHours{days: make(map[time.Weekday]bool)}
+	var err error
+	if hours.start, err = parseClock(start); err != nil {
+		return workingHours{}, fmt.Errorf("parsing start: %v", err)
+	}
+	if hours.end, err = parseClock(end); err != nil {
+		return workingHours{}, fmt.Errorf("parsing end: %v", err)
+	}
+	if hours.end <= hours.start {
+		return workingHours{}, fmt.Errorf("end (%s) must be after start (%s)", end, start)
+	}
+	for _, day := range days {
+		weekday, ok := weekdays[strings.ToLower(day)]
+		if !ok {
+			return workingHours{}, fmt.Errorf("unknown working day %q", day)
+		}
+		hours.days[weekday] = true
+	}
+	return hours, nil
+}
+
+func parseClock(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("expected HH:MM, got %q", s)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// ValidateWorkingHours checks the working hours and days of a configuration.
+func ValidateWorkingHours(start, end string, days []string) error {
+	_, err := parseWorkingHours(start, end, days)
+	return err
+}
+
+func workingHoursFromConfig(config apiserver.Configuration) (workingHours, error) {
+	start := config.WorkingHoursStart
+	if start == "" {
+		start = DefaultWorkingHoursStart
+	}
+	end := config.WorkingHoursEnd
+	if end == "" {
+		end = DefaultWorkingHoursEnd
+	}
+	days := config.WorkingDays
+	if days == nil {
+		days = DefaultWorkingDays
+	}
+	return parseWorkingHours(start, end, days)
+}
+
+// periods returns the working time between from and to.
+func (h workingHours) periods(from, to time.Time) []busyPeriod {
+	var periods []busyPeriod
+	for day := from; day.Before(to); day = day.AddDate(0, 0, 1) {
+		if !h.days[day.Weekday()] {
+			continue
+		}
+		midnight := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, day.Location())
+		periods = append(periods, busyPeriod{start: midnight.Add(h.start), end: midnight.Add(h.end)})
+	}
+	return periods
+}
+
+// Utilization holds the utilization KPIs of a room or equipment for the current day and week, in
+// the time zone of the configuration. Weeks start on Monday.
+type Utilization struct {
+	identifier string
+	assetType  string
+	// Booked hours within the working hours in percent of the working hours.
+	DayUtilization *float64 `eliona:"utilization_day" subtype:"status"`
+	DayBookedHours *float64 `eliona:"booked_hours_day" subtype:"status"`
+	DayBookings    *int32   `eliona:"bookings_day" subtype:"status"`
+	// Average number of attendees including the organizer.
+	DayAverageAttendees *float64 `eliona:"average_attendees_day" subtype:"status"`
+	// Average number of attendees in percent of the capacity of the room.
+	DayCapacityUse *float64 `eliona:"capacity_use_day" subtype:"status"`
+	// Share of bookings with more attendees than half the capacity, in percent.
+	DayLargeBookingShare  *float64 `eliona:"large_booking_share_day" subtype:"status"`
+	WeekUtilization       *float64 `eliona:"utilization_week" subtype:"status"`
+	WeekBookedHours       *float64 `eliona:"booked_hours_week" subtype:"status"`
+	WeekBookings          *int32   `eliona:"bookings_week" subtype:"status"`
+	WeekAverageAttendees  *float64 `eliona:"average_attendees_week" subtype:"status"`
+	WeekCapacityUse       *float64 `eliona:"capacity_use_week" subtype:"status"`
+	WeekLargeBookingShare *float64 `eliona:"large_booking_share_week" subtype:"status"`
+}
+
+func (u Utilization) AssetType() string {
+	return u.assetType
+}
+
+func (u Utilization) Id() string {
+	return u.identifier
+}
+
+// utilizationKPIs are the KPIs of one period.
+type utilizationKPIs struct {
+	utilization       *float64
+	bookedHours       *float64
+	bookings          *int32
+	averageAttendees  *float64
+	capacityUse       *float64
+	largeBookingShare *float64
+}
+
+// booking is an event in the calendar of a resource that blocks it.
+type booking struct {
+	start     time.Time
+	end       time.Time
+	attendees int
+}
+
+// GetUtilization calculates the utilization of the rooms and equipment from their calendars. It
+// returns nil if the utilization of the configuration was calculated less than
+// utilizationInterval ago.
+//
+// Reading the calendars needs the Calendars.Read permission. Resources whose calendar cannot be
+// read are left out, see UnreadableCalendars.
+func (g *GraphHelper) GetUtilization(config apiserver.Configuration, rooms []Room, equipment []Equipment) ([]Utilization, error) {
+	if last, ok := utilizationCalculatedAt.Load(*config.Id); ok && time.Since(last.(time.Time)) < utilizationInterval {
+		return nil, nil
+	}
+	tz, err := ParseTimeZone(config.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("parsing time zone: %v", err)
+	}
+	hours, err := workingHoursFromConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("parsing working hours: %v", err)
+	}
+
+	now := time.Now().In(tz.Location)
+	dayStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, tz.Location)
+	dayEnd := dayStart.AddDate(0, 0, 1)
+	weekStart := dayStart.AddDate(0, 0, -((int(dayStart.Weekday()) + 6) % 7))
+	weekEnd := weekStart.AddDate(0, 0, 7)
+
+	type resource struct {
+		identifier string
+		assetType  string
+		email      string
+		capacity   *int32
+	}
+	var resources []resource
+	for _, room := range rooms {
+		resources = append(resources, resource{room.Id(), room.AssetType(), *room.EmailAddress, room.Capacity})
+	}
+	for _, e := range equipment {
+		resources = append(resources, resource{e.Id(), e.AssetType(), *e.EmailAddress, nil})
+	}
+
+	var emails []string
+	for _, r := range resources {
+		emails = append(emails, r.email)
+	}
+	bookings := g.fetchWeekBookings(*config.Id, tz, emails, dayStart, dayEnd, weekStart, weekEnd)
+
+	var utilization []Utilization
+	for _, r := range resources {
+		bookings, ok := bookings[r.email]
+		if !ok {
+			continue
+		}
+		day := calculateKPIs(bookings, hours.periods(dayStart, dayEnd), dayStart, dayEnd, r.capacity)
+		week := calculateKPIs(bookings, hours.periods(weekStart, weekEnd), weekStart, weekEnd, r.capacity)
+		utilization = append(utilization, Utilization{
+			identifier:            r.identifier,
+			assetType:             r.assetType,
+			DayUtilization:        day.utilization,
+			DayBookedHours:        day.bookedHours,
+			DayBookings:           day.bookings,
+			DayAverageAttendees:   day.averageAttendees,
+			DayCapacityUse:        day.capacityUse,
+			DayLargeBookingShare:  day.largeBookingShare,
+			WeekUtilization:       week.utilization,
+			WeekBookedHours:       week.bookedHours,
+			WeekBookings:          week.bookings,
+			WeekAverageAttendees:  week.averageAttendees,
+			WeekCapacityUse:       week.capacityUse,
+			WeekLargeBookingShare: week.largeBookingShare,
+		})
+	}
+	utilizationCalculatedAt.Store(*config.Id, time.Now())
+	return utilization, nil
+}
+
+// calculateKPIs calculates the KPIs of the bookings overlapping the time between from and to.
+// Bookings reaching into the period from before or beyond it count with their part inside. Only
+// the booked time within the working periods counts as utilization.
+func calculateKPIs(bookings []booking, working []busyPeriod, from, to time.Time, capacity *int32) utilizationKPIs {
+	var kpis utilizationKPIs
+	var inPeriod []booking
+	for _, b := range bookings {
+		if b.start.Before(to) && b.end.After(from) {
+			b.start = maxTime(b.start, from)
+			b.end = minTime(b.end, to)
+			inPeriod = append(inPeriod, b)
+		}
+	}
+	count := int32(len(inPeriod))
+	kpis.bookings = &count
+
+	var workingTime, bookedTime time.Duration
+	for _, w := range working {
+		workingTime += w.end.Sub(w.start)
+		for _, b := range mergeBookings(inPeriod) {
+			start := maxTime(w.start, b.start)
+			end := minTime(w.end, b.end)
+			if end.After(start) {
+				bookedTime += end.Sub(start)
+			}
+		}
+	}
+	bookedHours := bookedTime.Hours()
+	kpis.bookedHours = &bookedHours
+	if workingTime > 0 {
+		utilization := percent(bookedTime.Hours(), workingTime.Hours())
+		kpis.utilization = &utilization
+	}
+
+	if count == 0 {
+		return kpis
+	}
+	var attendees, large int
+	for _, b := range inPeriod {
+		attendees += b.attendees
+		if capacity != nil && b.attendees*2 > int(*capacity) {
+			large++
+		}
+	}
+	average := float64(attendees) / float64(count)
+	kpis.averageAttendees = &average
+	if capacity != nil && *capacity > 0 {
+		capacityUse := percent(average, float64(*capacity))
+		kpis.capacityUse = &capacityUse
+		largeShare := percent(float64(large), float64(count))
+		kpis.largeBookingShare = &largeShare
+	}
+	return kpis
+}
+
+// mergeBookings returns the time spans covered by the bookings, so that overlapping bookings are
+// not counted twice.
+func mergeBookings(bookings []booking) []busyPeriod {
+	sorted := slices.Clone(bookings)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].start.Before(sorted[j].start) })
+	var merged []busyPeriod
+	for _, b := range sorted {
+		if n := len(merged); n > 0 && !b.start.After(merged[n-1].end) {
+			merged[n-1].end = maxTime(merged[n-1].end, b.end)
+			continue
+		}
+		merged = append(merged, busyPeriod{start: b.start, end: b.end})
+	}
+	return merged
+}
+
+func percent(part, whole float64) float64 {
+	return part / whole * 100
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+// fetchWeekBookings returns the bookings of the resources in the week, by email address. The
+// week is read once a day and kept in weekBookingsCache, afterwards only the day is read again.
+// Resources whose calendar cannot be read are missing in the result and are remembered in
+// unreadableCalendars.
+func (g *GraphHelper) fetchWeekBookings(configID int64, tz TimeZone, emails []string, dayStart, dayEnd, weekStart, weekEnd time.Time) map[string][]booking {
+	cache := &weekBookings{day: dayStart, bookings: make(map[string][]booking)}
+	if cached, ok := weekBookingsCache.Load(configID); ok && cached.(*weekBookings).day.Equal(dayStart) {
+		cache = cached.(*weekBookings)
+	}
+	var requests []calendarViewRequest
+	for _, email := range emails {
+		if _, ok := cache.bookings[email]; ok {
+			requests = append(requests, calendarViewRequest{email: email, from: dayStart, to: dayEnd})
+		} else {
+			requests = append(requests, calendarViewRequest{email: email, from: weekStart, to: weekEnd})
+		}
+	}
+	calendars, failed := g.fetchCalendarViews(context.Background(), tz, requests,
+		[]string{"start", "end", "attendees", "organizer", "isCancelled", "showAs"})
+
+	week := make(map[string][]booking)
+	for _, email := range emails {
+		calendar, ok := calendars[email]
+		if !ok {
+			continue
+		}
+		read, err := bookingsFromEvents(tz, calendar)
+		if err != nil {
+			failed[email] = err
+			continue
+		}
+		cached, ok := cache.bookings[email]
+		if !ok {
+			cache.bookings[email] = read
+			week[email] = read
+			continue
+		}
+		// The bookings read for the day replace the cached ones touching the day.
+		for _, b := range cached {
+			if !(b.start.Before(dayEnd) && b.end.After(dayStart)) {
+				read = append(read, b)
+			}
+		}
+		week[email] = read
+	}
+	weekBookingsCache.Store(configID, cache)
+
+	previous := UnreadableCalendars(configID)
+	unreadable := make(map[string]string)
+	for email, err := range failed {
+		if _, known := previous[email]; known {
+			log.Debug("microsoft-365", "fetching bookings of %s: %v", email, err)
+		} else {
+			log.Warn("microsoft-365", "Utilization of %s cannot be calculated, its calendar cannot be read (is Calendars.Read granted?): %v", email, err)
+		}
+		unreadable[email] = err.Error()
+	}
+	unreadableCalendars.Store(configID, unreadable)
+	return week
+}
+
+// bookingsFromEvents returns the events blocking the resource. Cancelled events and events shown
+// as free are left out.
+func bookingsFromEvents(tz TimeZone, events []models.Eventable) ([]booking, error) {
+	var bookings []booking
+	for _, event := range events {
+		if event.GetStart() == nil || event.GetStart().GetDateTime() == nil ||
+			event.GetEnd() == nil || event.GetEnd().GetDateTime() == nil {
+			continue
+		}
+		if c := event.GetIsCancelled(); c != nil && *c {
+			continue
+		}
+		if s := event.GetShowAs(); s != nil && *s == models.FREE_FREEBUSYSTATUS {
+			continue
+		}
+		b := booking{attendees: eventAttendees(event)}
+		var err error
+		if b.start, err = tz.parse(*event.GetStart().GetDateTime()); err != nil {
+			return nil, fmt.Errorf("parsing event start: %v", err)
+		}
+		if b.end, err = tz.parse(*event.GetEnd().GetDateTime()); err != nil {
+			return nil, fmt.Errorf("parsing event end: %v", err)
+		}
+		bookings = append(bookings, b)
+	}
+	return bookings, nil
+}
+
+// eventAttendees counts the people attending the event, i.e. the organizer and all attendees
+// except resources like the room itself.
+func eventAttendees(event models.Eventable) int {
+	var organizer string
+	if o := event.GetOrganizer(); o != nil && o.GetEmailAddress() != nil && o.GetEmailAddress().GetAddress() != nil {
+		organizer = strings.ToLower(*o.GetEmailAddress().GetAddress())
+	}
+	count := 1
+	for _, attendee := range event.GetAttendees() {
+		if t := attendee.GetTypeEscaped(); t != nil && *t == models.RESOURCE_ATTENDEETYPE {
+			continue
+		}
+		if e := attendee.GetEmailAddress(); e != nil && e.GetAddress() != nil && strings.ToLower(*e.GetAddress()) == organizer {
+			continue
+		}
+		count++
+	}
+	return count
+}
@@ -0,0 +1,100 @@
+package msgraph
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMergeBookings(t *testing.T) {
+	tz := testTimeZone(t)
+	bookings := []booking{
+		{start: at(tz, 13, 0), end: at(tz, 14, 0)},
+		{start: at(tz, 9, 0), end: at(tz, 10, 0)},
+		// Overlapping and touching bookings are merged.
+		{start: at(tz, 9, 30), end: at(tz, 10, 30)},
+		{start: at(tz, 10, 30), end: at(tz, 11, 0)},
+		// Contained in the previous ones.
+		{start: at(tz, 9, 15), end: at(tz, 9, 45)},
+	}
+	merged := mergeBookings(bookings)
+	want := []busyPeriod{period(tz, 9, 0, 11, 0), period(tz, 13, 0, 14, 0)}
+	if len(merged) != len(want) {
+		t.Fatalf("got %d periods, want %d", len(merged), len(want))
+	}
+	for i, p := range merged {
+		if !p.start.Equal(want[i].start) || !p.end.Equal(want[i].end) {
+			t.Errorf("period %d is %v - %v, want %v - %v", i, p.start, p.end, want[i].start, want[i].end)
+		}
+	}
+	if !bookings[0].start.Equal(at(tz, 13, 0)) {
+		t.Error("mergeBookings reordered the given bookings")
+	}
+}
+
+func TestCalculateKPIs(t *testing.T) {
+	tz := testTimeZone(t)
+	working := []busyPeriod{period(tz, 8, 0, 12, 0), period(tz, 13, 0, 18, 0)}
+	bookings := []booking{
+		// Reaches into the period, counts from 8:00 as working time.
+		{start: at(tz, 5, 0), end: at(tz, 9, 0), attendees: 2},
+		{start: at(tz, 10, 0), end: at(tz, 11, 0), attendees: 6},
+		{start: at(tz, 10, 30), end: at(tz, 11, 30), attendees: 4},
+		// During the lunch break, not working time.
+		{start: at(tz, 12, 0), end: at(tz, 13, 0), attendees: 1},
+		// Outside of the period.
+		{start: at(tz, 21, 0), end: at(tz, 22, 0), attendees: 8},
+	}
+	capacity := int32(8)
+	kpis := calculateKPIs(bookings, working, at(tz, 6, 0), at(tz, 20, 0), &capacity)
+
+	if kpis.bookings == nil || *kpis.bookings != 4 {
+		t.Errorf("bookings = %v, want 4", kpis.bookings)
+	}
+	expectFloat(t, "booked hours", kpis.bookedHours, 2.5)
+	expectFloat(t, "utilization", kpis.utilization, 2.5/9*100)
+	expectFloat(t, "average attendees", kpis.averageAttendees, 3.25)
+	expectFloat(t, "capacity use", kpis.capacityUse, 3.25/8*100)
+	expectFloat(t, "large booking share", kpis.largeBookingShare, 25)
+}
+
+func TestCalculateKPIsWithoutCapacity(t *testing.T) {
+	tz := testTimeZone(t)
+	working := []busyPeriod{period(tz, 8, 0, 18, 0)}
+	bookings := []booking{{start: at(tz, 9, 0), end: at(tz, 10, 0), attendees: 3}}
+	kpis := calculateKPIs(bookings, working, at(tz, 0, 0), at(tz, 23, 0), nil)
+
+	expectFloat(t, "utilization", kpis.utilization, 10)
+	expectFloat(t, "average attendees", kpis.averageAttendees, 3)
+	if kpis.capacityUse != nil || kpis.largeBookingShare != nil {
+		t.Errorf("capacity use %v and large booking share %v, want none without capacity", kpis.capacityUse, kpis.largeBookingShare)
+	}
+}
+
+func TestCalculateKPIsWithoutBookings(t *testing.T) {
+	tz := testTimeZone(t)
+	kpis := calculateKPIs(nil, []busyPeriod{period(tz, 8, 0, 18, 0)}, at(tz, 0, 0), at(tz, 23, 0), nil)
+	if kpis.bookings == nil || *kpis.bookings != 0 {
+		t.Errorf("bookings = %v, want 0", kpis.bookings)
+	}
+	expectFloat(t, "utilization", kpis.utilization, 0)
+	if kpis.averageAttendees != nil {
+		t.Errorf("average attendees = %v, want none", *kpis.averageAttendees)
+	}
+
+	// Without working time, e.g. on weekends, there is no utilization.
+	kpis = calculateKPIs(nil, nil, at(tz, 0, 0), at(tz, 23, 0), nil)
+	if kpis.utilization != nil {
+		t.Errorf("utilization = %v, want none", *kpis.utilization)
+	}
+}
+
+func expectFloat(t *testing.T, name string, got *float64, want float64) {
+	t.Helper()
+	if got == nil {
+		t.Errorf("%s is missing, want %v", name, want)
+		return
+	}
+	if math.Abs(*got-want) > 1e-9 {
+		t.Errorf("%s = %v, want %v", name, *got, want)
+	}
+}